@@ -19,30 +19,46 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	User_OpenCardHandle_FullMethodName      = "/api.user.v1.User/OpenCardHandle"
-	User_CardStatusHandle_FullMethodName    = "/api.user.v1.User/CardStatusHandle"
-	User_Deposit_FullMethodName             = "/api.user.v1.User/Deposit"
-	User_AdminWithdrawEth_FullMethodName    = "/api.user.v1.User/AdminWithdrawEth"
-	User_RewardCardTwo_FullMethodName       = "/api.user.v1.User/RewardCardTwo"
-	User_AdminRewardList_FullMethodName     = "/api.user.v1.User/AdminRewardList"
-	User_AdminUserList_FullMethodName       = "/api.user.v1.User/AdminUserList"
-	User_AdminCardTwoList_FullMethodName    = "/api.user.v1.User/AdminCardTwoList"
-	User_AdminCardTwoListNew_FullMethodName = "/api.user.v1.User/AdminCardTwoListNew"
-	User_AdminUserBind_FullMethodName       = "/api.user.v1.User/AdminUserBind"
-	User_AdminUserBindTwo_FullMethodName    = "/api.user.v1.User/AdminUserBindTwo"
-	User_AdminLogin_FullMethodName          = "/api.user.v1.User/AdminLogin"
-	User_UpdateUserInfoTo_FullMethodName    = "/api.user.v1.User/UpdateUserInfoTo"
-	User_UpdateCanVip_FullMethodName        = "/api.user.v1.User/UpdateCanVip"
-	User_SetVipThree_FullMethodName         = "/api.user.v1.User/SetVipThree"
-	User_SetUserCount_FullMethodName        = "/api.user.v1.User/SetUserCount"
-	User_AdminConfig_FullMethodName         = "/api.user.v1.User/AdminConfig"
-	User_AdminConfigUpdate_FullMethodName   = "/api.user.v1.User/AdminConfigUpdate"
-	User_UpdateAllCard_FullMethodName       = "/api.user.v1.User/UpdateAllCard"
-	User_UpdateAllCardOne_FullMethodName    = "/api.user.v1.User/UpdateAllCardOne"
-	User_AllInfo_FullMethodName             = "/api.user.v1.User/AllInfo"
-	User_EmailGet_FullMethodName            = "/api.user.v1.User/EmailGet"
-	User_PullAllCard_FullMethodName         = "/api.user.v1.User/PullAllCard"
-	User_AutoUpdateAllCard_FullMethodName   = "/api.user.v1.User/AutoUpdateAllCard"
+	User_OpenCardHandle_FullMethodName           = "/api.user.v1.User/OpenCardHandle"
+	User_CardStatusHandle_FullMethodName         = "/api.user.v1.User/CardStatusHandle"
+	User_Deposit_FullMethodName                  = "/api.user.v1.User/Deposit"
+	User_AdminDepositReconcile_FullMethodName    = "/api.user.v1.User/AdminDepositReconcile"
+	User_AdminDepositReplay_FullMethodName       = "/api.user.v1.User/AdminDepositReplay"
+	User_AdminDepositSmallList_FullMethodName    = "/api.user.v1.User/AdminDepositSmallList"
+	User_AdminDepositSmallResolve_FullMethodName = "/api.user.v1.User/AdminDepositSmallResolve"
+	User_AdminJobList_FullMethodName             = "/api.user.v1.User/AdminJobList"
+	User_AdminJobRun_FullMethodName              = "/api.user.v1.User/AdminJobRun"
+	User_AdminWithdrawList_FullMethodName        = "/api.user.v1.User/AdminWithdrawList"
+	User_AdminWithdrawReview_FullMethodName      = "/api.user.v1.User/AdminWithdrawReview"
+	User_AdminWithdrawPause_FullMethodName       = "/api.user.v1.User/AdminWithdrawPause"
+	User_AdminWithdrawEth_FullMethodName         = "/api.user.v1.User/AdminWithdrawEth"
+	User_RewardCardTwo_FullMethodName            = "/api.user.v1.User/RewardCardTwo"
+	User_AdminRewardList_FullMethodName          = "/api.user.v1.User/AdminRewardList"
+	User_Transfer_FullMethodName                 = "/api.user.v1.User/Transfer"
+	User_Withdraw_FullMethodName                 = "/api.user.v1.User/Withdraw"
+	User_AdminTransferList_FullMethodName        = "/api.user.v1.User/AdminTransferList"
+	User_SetLockTransfer_FullMethodName          = "/api.user.v1.User/SetLockTransfer"
+	User_AdminBalanceDriftList_FullMethodName    = "/api.user.v1.User/AdminBalanceDriftList"
+	User_AdminUserStatement_FullMethodName       = "/api.user.v1.User/AdminUserStatement"
+	User_AdminRewardReasonList_FullMethodName    = "/api.user.v1.User/AdminRewardReasonList"
+	User_AdminUserList_FullMethodName            = "/api.user.v1.User/AdminUserList"
+	User_AdminCardTwoList_FullMethodName         = "/api.user.v1.User/AdminCardTwoList"
+	User_AdminCardTwoListNew_FullMethodName      = "/api.user.v1.User/AdminCardTwoListNew"
+	User_AdminUserBind_FullMethodName            = "/api.user.v1.User/AdminUserBind"
+	User_AdminUserBindTwo_FullMethodName         = "/api.user.v1.User/AdminUserBindTwo"
+	User_AdminLogin_FullMethodName               = "/api.user.v1.User/AdminLogin"
+	User_UpdateUserInfoTo_FullMethodName         = "/api.user.v1.User/UpdateUserInfoTo"
+	User_UpdateCanVip_FullMethodName             = "/api.user.v1.User/UpdateCanVip"
+	User_SetVipThree_FullMethodName              = "/api.user.v1.User/SetVipThree"
+	User_SetUserCount_FullMethodName             = "/api.user.v1.User/SetUserCount"
+	User_AdminConfig_FullMethodName              = "/api.user.v1.User/AdminConfig"
+	User_AdminConfigUpdate_FullMethodName        = "/api.user.v1.User/AdminConfigUpdate"
+	User_UpdateAllCard_FullMethodName            = "/api.user.v1.User/UpdateAllCard"
+	User_UpdateAllCardOne_FullMethodName         = "/api.user.v1.User/UpdateAllCardOne"
+	User_AllInfo_FullMethodName                  = "/api.user.v1.User/AllInfo"
+	User_EmailGet_FullMethodName                 = "/api.user.v1.User/EmailGet"
+	User_PullAllCard_FullMethodName              = "/api.user.v1.User/PullAllCard"
+	User_AutoUpdateAllCard_FullMethodName        = "/api.user.v1.User/AutoUpdateAllCard"
 )
 
 // UserClient is the client API for User service.
@@ -55,11 +71,43 @@ type UserClient interface {
 	CardStatusHandle(ctx context.Context, in *CardStatusHandleRequest, opts ...grpc.CallOption) (*CardStatusHandleReply, error)
 	// 充值
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
+	// 充值对账，链上 getUsersAmountByIndex 与库内充值记录比对
+	AdminDepositReconcile(ctx context.Context, in *AdminDepositReconcileRequest, opts ...grpc.CallOption) (*AdminDepositReconcileReply, error)
+	// 补入账，入账关闭期间只记录未加余额的充值
+	AdminDepositReplay(ctx context.Context, in *AdminDepositReplayRequest, opts ...grpc.CallOption) (*AdminDepositReplayReply, error)
+	// 小额充值列表，低于最小充值金额的待累计和待审核记录
+	AdminDepositSmallList(ctx context.Context, in *AdminDepositSmallListRequest, opts ...grpc.CallOption) (*AdminDepositSmallListReply, error)
+	// 小额充值处理，入账或驳回
+	AdminDepositSmallResolve(ctx context.Context, in *AdminDepositSmallResolveRequest, opts ...grpc.CallOption) (*AdminDepositSmallResolveReply, error)
+	// 定时任务列表
+	AdminJobList(ctx context.Context, in *AdminJobListRequest, opts ...grpc.CallOption) (*AdminJobListReply, error)
+	// 手动执行定时任务
+	AdminJobRun(ctx context.Context, in *AdminJobRunRequest, opts ...grpc.CallOption) (*AdminJobRunReply, error)
+	// 提现列表
+	AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...grpc.CallOption) (*AdminWithdrawListReply, error)
+	// 提现审核，通过或驳回，驳回退回余额
+	AdminWithdrawReview(ctx context.Context, in *AdminWithdrawReviewRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewReply, error)
+	// 提现队列暂停状态，热钱包余额不足时自动暂停
+	AdminWithdrawPause(ctx context.Context, in *AdminWithdrawPauseRequest, opts ...grpc.CallOption) (*AdminWithdrawPauseReply, error)
 	// 提现
 	AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error)
 	// 实体卡分红
 	RewardCardTwo(ctx context.Context, in *RewardCardTwoRequest, opts ...grpc.CallOption) (*RewardCardTwoReply, error)
 	AdminRewardList(ctx context.Context, in *AdminRewardListRequest, opts ...grpc.CallOption) (*AdminRewardListReply, error)
+	// 用户之间划转，send_body.idemKey 同一用户内唯一，重复提交返回第一次的结果
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferReply, error)
+	// 用户提现到自己的地址，按手续费配置扣费，超过审核金额的待审核
+	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
+	// 划转记录
+	AdminTransferList(ctx context.Context, in *AdminTransferListRequest, opts ...grpc.CallOption) (*AdminTransferListReply, error)
+	// 设置用户禁止划转，转出和收款都拒绝
+	SetLockTransfer(ctx context.Context, in *SetLockTransferRequest, opts ...grpc.CallOption) (*SetLockTransferReply, error)
+	// 余额对账差异，每晚按 reward、withdraw、transfer 记录重算余额
+	AdminBalanceDriftList(ctx context.Context, in *AdminBalanceDriftListRequest, opts ...grpc.CallOption) (*AdminBalanceDriftListReply, error)
+	// 用户对账单，每笔收支按时间顺序带余额，导出 CSV 用 /api/admin_dhb/user_statement_csv
+	AdminUserStatement(ctx context.Context, in *AdminUserStatementRequest, opts ...grpc.CallOption) (*AdminUserStatementReply, error)
+	// 收益/流水原因和文案，后台按这里展示，不要写死编号
+	AdminRewardReasonList(ctx context.Context, in *AdminRewardReasonListRequest, opts ...grpc.CallOption) (*AdminRewardReasonListReply, error)
 	AdminUserList(ctx context.Context, in *AdminUserListRequest, opts ...grpc.CallOption) (*AdminUserListReply, error)
 	AdminCardTwoList(ctx context.Context, in *AdminCardTwoRequest, opts ...grpc.CallOption) (*AdminCardTwoReply, error)
 	AdminCardTwoListNew(ctx context.Context, in *AdminCardTwoRequest, opts ...grpc.CallOption) (*AdminCardTwoNewReply, error)
@@ -121,6 +169,87 @@ func (c *userClient) Deposit(ctx context.Context, in *DepositRequest, opts ...gr
 	return out, nil
 }
 
+func (c *userClient) AdminDepositReconcile(ctx context.Context, in *AdminDepositReconcileRequest, opts ...grpc.CallOption) (*AdminDepositReconcileReply, error) {
+	out := new(AdminDepositReconcileReply)
+	err := c.cc.Invoke(ctx, User_AdminDepositReconcile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminDepositReplay(ctx context.Context, in *AdminDepositReplayRequest, opts ...grpc.CallOption) (*AdminDepositReplayReply, error) {
+	out := new(AdminDepositReplayReply)
+	err := c.cc.Invoke(ctx, User_AdminDepositReplay_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminDepositSmallList(ctx context.Context, in *AdminDepositSmallListRequest, opts ...grpc.CallOption) (*AdminDepositSmallListReply, error) {
+	out := new(AdminDepositSmallListReply)
+	err := c.cc.Invoke(ctx, User_AdminDepositSmallList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminDepositSmallResolve(ctx context.Context, in *AdminDepositSmallResolveRequest, opts ...grpc.CallOption) (*AdminDepositSmallResolveReply, error) {
+	out := new(AdminDepositSmallResolveReply)
+	err := c.cc.Invoke(ctx, User_AdminDepositSmallResolve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminJobList(ctx context.Context, in *AdminJobListRequest, opts ...grpc.CallOption) (*AdminJobListReply, error) {
+	out := new(AdminJobListReply)
+	err := c.cc.Invoke(ctx, User_AdminJobList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminJobRun(ctx context.Context, in *AdminJobRunRequest, opts ...grpc.CallOption) (*AdminJobRunReply, error) {
+	out := new(AdminJobRunReply)
+	err := c.cc.Invoke(ctx, User_AdminJobRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...grpc.CallOption) (*AdminWithdrawListReply, error) {
+	out := new(AdminWithdrawListReply)
+	err := c.cc.Invoke(ctx, User_AdminWithdrawList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminWithdrawReview(ctx context.Context, in *AdminWithdrawReviewRequest, opts ...grpc.CallOption) (*AdminWithdrawReviewReply, error) {
+	out := new(AdminWithdrawReviewReply)
+	err := c.cc.Invoke(ctx, User_AdminWithdrawReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminWithdrawPause(ctx context.Context, in *AdminWithdrawPauseRequest, opts ...grpc.CallOption) (*AdminWithdrawPauseReply, error) {
+	out := new(AdminWithdrawPauseReply)
+	err := c.cc.Invoke(ctx, User_AdminWithdrawPause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error) {
 	out := new(AdminWithdrawEthReply)
 	err := c.cc.Invoke(ctx, User_AdminWithdrawEth_FullMethodName, in, out, opts...)
@@ -148,6 +277,69 @@ func (c *userClient) AdminRewardList(ctx context.Context, in *AdminRewardListReq
 	return out, nil
 }
 
+func (c *userClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferReply, error) {
+	out := new(TransferReply)
+	err := c.cc.Invoke(ctx, User_Transfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error) {
+	out := new(WithdrawReply)
+	err := c.cc.Invoke(ctx, User_Withdraw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminTransferList(ctx context.Context, in *AdminTransferListRequest, opts ...grpc.CallOption) (*AdminTransferListReply, error) {
+	out := new(AdminTransferListReply)
+	err := c.cc.Invoke(ctx, User_AdminTransferList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetLockTransfer(ctx context.Context, in *SetLockTransferRequest, opts ...grpc.CallOption) (*SetLockTransferReply, error) {
+	out := new(SetLockTransferReply)
+	err := c.cc.Invoke(ctx, User_SetLockTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminBalanceDriftList(ctx context.Context, in *AdminBalanceDriftListRequest, opts ...grpc.CallOption) (*AdminBalanceDriftListReply, error) {
+	out := new(AdminBalanceDriftListReply)
+	err := c.cc.Invoke(ctx, User_AdminBalanceDriftList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminUserStatement(ctx context.Context, in *AdminUserStatementRequest, opts ...grpc.CallOption) (*AdminUserStatementReply, error) {
+	out := new(AdminUserStatementReply)
+	err := c.cc.Invoke(ctx, User_AdminUserStatement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminRewardReasonList(ctx context.Context, in *AdminRewardReasonListRequest, opts ...grpc.CallOption) (*AdminRewardReasonListReply, error) {
+	out := new(AdminRewardReasonListReply)
+	err := c.cc.Invoke(ctx, User_AdminRewardReasonList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) AdminUserList(ctx context.Context, in *AdminUserListRequest, opts ...grpc.CallOption) (*AdminUserListReply, error) {
 	out := new(AdminUserListReply)
 	err := c.cc.Invoke(ctx, User_AdminUserList_FullMethodName, in, out, opts...)
@@ -320,11 +512,43 @@ type UserServer interface {
 	CardStatusHandle(context.Context, *CardStatusHandleRequest) (*CardStatusHandleReply, error)
 	// 充值
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
+	// 充值对账，链上 getUsersAmountByIndex 与库内充值记录比对
+	AdminDepositReconcile(context.Context, *AdminDepositReconcileRequest) (*AdminDepositReconcileReply, error)
+	// 补入账，入账关闭期间只记录未加余额的充值
+	AdminDepositReplay(context.Context, *AdminDepositReplayRequest) (*AdminDepositReplayReply, error)
+	// 小额充值列表，低于最小充值金额的待累计和待审核记录
+	AdminDepositSmallList(context.Context, *AdminDepositSmallListRequest) (*AdminDepositSmallListReply, error)
+	// 小额充值处理，入账或驳回
+	AdminDepositSmallResolve(context.Context, *AdminDepositSmallResolveRequest) (*AdminDepositSmallResolveReply, error)
+	// 定时任务列表
+	AdminJobList(context.Context, *AdminJobListRequest) (*AdminJobListReply, error)
+	// 手动执行定时任务
+	AdminJobRun(context.Context, *AdminJobRunRequest) (*AdminJobRunReply, error)
+	// 提现列表
+	AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error)
+	// 提现审核，通过或驳回，驳回退回余额
+	AdminWithdrawReview(context.Context, *AdminWithdrawReviewRequest) (*AdminWithdrawReviewReply, error)
+	// 提现队列暂停状态，热钱包余额不足时自动暂停
+	AdminWithdrawPause(context.Context, *AdminWithdrawPauseRequest) (*AdminWithdrawPauseReply, error)
 	// 提现
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
 	// 实体卡分红
 	RewardCardTwo(context.Context, *RewardCardTwoRequest) (*RewardCardTwoReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
+	// 用户之间划转，send_body.idemKey 同一用户内唯一，重复提交返回第一次的结果
+	Transfer(context.Context, *TransferRequest) (*TransferReply, error)
+	// 用户提现到自己的地址，按手续费配置扣费，超过审核金额的待审核
+	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	// 划转记录
+	AdminTransferList(context.Context, *AdminTransferListRequest) (*AdminTransferListReply, error)
+	// 设置用户禁止划转，转出和收款都拒绝
+	SetLockTransfer(context.Context, *SetLockTransferRequest) (*SetLockTransferReply, error)
+	// 余额对账差异，每晚按 reward、withdraw、transfer 记录重算余额
+	AdminBalanceDriftList(context.Context, *AdminBalanceDriftListRequest) (*AdminBalanceDriftListReply, error)
+	// 用户对账单，每笔收支按时间顺序带余额，导出 CSV 用 /api/admin_dhb/user_statement_csv
+	AdminUserStatement(context.Context, *AdminUserStatementRequest) (*AdminUserStatementReply, error)
+	// 收益/流水原因和文案，后台按这里展示，不要写死编号
+	AdminRewardReasonList(context.Context, *AdminRewardReasonListRequest) (*AdminRewardReasonListReply, error)
 	AdminUserList(context.Context, *AdminUserListRequest) (*AdminUserListReply, error)
 	AdminCardTwoList(context.Context, *AdminCardTwoRequest) (*AdminCardTwoReply, error)
 	AdminCardTwoListNew(context.Context, *AdminCardTwoRequest) (*AdminCardTwoNewReply, error)
@@ -365,6 +589,33 @@ func (UnimplementedUserServer) CardStatusHandle(context.Context, *CardStatusHand
 func (UnimplementedUserServer) Deposit(context.Context, *DepositRequest) (*DepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (UnimplementedUserServer) AdminDepositReconcile(context.Context, *AdminDepositReconcileRequest) (*AdminDepositReconcileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositReconcile not implemented")
+}
+func (UnimplementedUserServer) AdminDepositReplay(context.Context, *AdminDepositReplayRequest) (*AdminDepositReplayReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositReplay not implemented")
+}
+func (UnimplementedUserServer) AdminDepositSmallList(context.Context, *AdminDepositSmallListRequest) (*AdminDepositSmallListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositSmallList not implemented")
+}
+func (UnimplementedUserServer) AdminDepositSmallResolve(context.Context, *AdminDepositSmallResolveRequest) (*AdminDepositSmallResolveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositSmallResolve not implemented")
+}
+func (UnimplementedUserServer) AdminJobList(context.Context, *AdminJobListRequest) (*AdminJobListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminJobList not implemented")
+}
+func (UnimplementedUserServer) AdminJobRun(context.Context, *AdminJobRunRequest) (*AdminJobRunReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminJobRun not implemented")
+}
+func (UnimplementedUserServer) AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawList not implemented")
+}
+func (UnimplementedUserServer) AdminWithdrawReview(context.Context, *AdminWithdrawReviewRequest) (*AdminWithdrawReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReview not implemented")
+}
+func (UnimplementedUserServer) AdminWithdrawPause(context.Context, *AdminWithdrawPauseRequest) (*AdminWithdrawPauseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawPause not implemented")
+}
 func (UnimplementedUserServer) AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawEth not implemented")
 }
@@ -374,6 +625,27 @@ func (UnimplementedUserServer) RewardCardTwo(context.Context, *RewardCardTwoRequ
 func (UnimplementedUserServer) AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminRewardList not implemented")
 }
+func (UnimplementedUserServer) Transfer(context.Context, *TransferRequest) (*TransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedUserServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedUserServer) AdminTransferList(context.Context, *AdminTransferListRequest) (*AdminTransferListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminTransferList not implemented")
+}
+func (UnimplementedUserServer) SetLockTransfer(context.Context, *SetLockTransferRequest) (*SetLockTransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLockTransfer not implemented")
+}
+func (UnimplementedUserServer) AdminBalanceDriftList(context.Context, *AdminBalanceDriftListRequest) (*AdminBalanceDriftListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminBalanceDriftList not implemented")
+}
+func (UnimplementedUserServer) AdminUserStatement(context.Context, *AdminUserStatementRequest) (*AdminUserStatementReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserStatement not implemented")
+}
+func (UnimplementedUserServer) AdminRewardReasonList(context.Context, *AdminRewardReasonListRequest) (*AdminRewardReasonListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRewardReasonList not implemented")
+}
 func (UnimplementedUserServer) AdminUserList(context.Context, *AdminUserListRequest) (*AdminUserListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminUserList not implemented")
 }
@@ -495,6 +767,168 @@ func _User_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_AdminDepositReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminDepositReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminDepositReconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminDepositReconcile(ctx, req.(*AdminDepositReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminDepositReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminDepositReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminDepositReplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminDepositReplay(ctx, req.(*AdminDepositReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminDepositSmallList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositSmallListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminDepositSmallList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminDepositSmallList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminDepositSmallList(ctx, req.(*AdminDepositSmallListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminDepositSmallResolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositSmallResolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminDepositSmallResolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminDepositSmallResolve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminDepositSmallResolve(ctx, req.(*AdminDepositSmallResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminJobList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminJobListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminJobList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminJobList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminJobList(ctx, req.(*AdminJobListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminJobRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminJobRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminJobRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminJobRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminJobRun(ctx, req.(*AdminJobRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminWithdrawList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminWithdrawList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminWithdrawList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminWithdrawList(ctx, req.(*AdminWithdrawListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminWithdrawReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminWithdrawReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminWithdrawReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminWithdrawReview(ctx, req.(*AdminWithdrawReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminWithdrawPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminWithdrawPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminWithdrawPause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminWithdrawPause(ctx, req.(*AdminWithdrawPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_AdminWithdrawEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminWithdrawEthRequest)
 	if err := dec(in); err != nil {
@@ -549,6 +983,132 @@ func _User_AdminRewardList_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Withdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminTransferList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTransferListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminTransferList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminTransferList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminTransferList(ctx, req.(*AdminTransferListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetLockTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLockTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetLockTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetLockTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetLockTransfer(ctx, req.(*SetLockTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminBalanceDriftList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBalanceDriftListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminBalanceDriftList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminBalanceDriftList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminBalanceDriftList(ctx, req.(*AdminBalanceDriftListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminUserStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminUserStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminUserStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminUserStatement(ctx, req.(*AdminUserStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminRewardReasonList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRewardReasonListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminRewardReasonList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminRewardReasonList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminRewardReasonList(ctx, req.(*AdminRewardReasonListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_AdminUserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminUserListRequest)
 	if err := dec(in); err != nil {
@@ -892,6 +1452,42 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _User_Deposit_Handler,
 		},
+		{
+			MethodName: "AdminDepositReconcile",
+			Handler:    _User_AdminDepositReconcile_Handler,
+		},
+		{
+			MethodName: "AdminDepositReplay",
+			Handler:    _User_AdminDepositReplay_Handler,
+		},
+		{
+			MethodName: "AdminDepositSmallList",
+			Handler:    _User_AdminDepositSmallList_Handler,
+		},
+		{
+			MethodName: "AdminDepositSmallResolve",
+			Handler:    _User_AdminDepositSmallResolve_Handler,
+		},
+		{
+			MethodName: "AdminJobList",
+			Handler:    _User_AdminJobList_Handler,
+		},
+		{
+			MethodName: "AdminJobRun",
+			Handler:    _User_AdminJobRun_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawList",
+			Handler:    _User_AdminWithdrawList_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawReview",
+			Handler:    _User_AdminWithdrawReview_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawPause",
+			Handler:    _User_AdminWithdrawPause_Handler,
+		},
 		{
 			MethodName: "AdminWithdrawEth",
 			Handler:    _User_AdminWithdrawEth_Handler,
@@ -904,6 +1500,34 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminRewardList",
 			Handler:    _User_AdminRewardList_Handler,
 		},
+		{
+			MethodName: "Transfer",
+			Handler:    _User_Transfer_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _User_Withdraw_Handler,
+		},
+		{
+			MethodName: "AdminTransferList",
+			Handler:    _User_AdminTransferList_Handler,
+		},
+		{
+			MethodName: "SetLockTransfer",
+			Handler:    _User_SetLockTransfer_Handler,
+		},
+		{
+			MethodName: "AdminBalanceDriftList",
+			Handler:    _User_AdminBalanceDriftList_Handler,
+		},
+		{
+			MethodName: "AdminUserStatement",
+			Handler:    _User_AdminUserStatement_Handler,
+		},
+		{
+			MethodName: "AdminRewardReasonList",
+			Handler:    _User_AdminRewardReasonList_Handler,
+		},
 		{
 			MethodName: "AdminUserList",
 			Handler:    _User_AdminUserList_Handler,
@@ -19,16 +19,29 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserAdminBalanceDriftList = "/api.user.v1.User/AdminBalanceDriftList"
 const OperationUserAdminCardTwoList = "/api.user.v1.User/AdminCardTwoList"
 const OperationUserAdminCardTwoListNew = "/api.user.v1.User/AdminCardTwoListNew"
 const OperationUserAdminConfig = "/api.user.v1.User/AdminConfig"
 const OperationUserAdminConfigUpdate = "/api.user.v1.User/AdminConfigUpdate"
+const OperationUserAdminDepositReconcile = "/api.user.v1.User/AdminDepositReconcile"
+const OperationUserAdminDepositReplay = "/api.user.v1.User/AdminDepositReplay"
+const OperationUserAdminDepositSmallList = "/api.user.v1.User/AdminDepositSmallList"
+const OperationUserAdminDepositSmallResolve = "/api.user.v1.User/AdminDepositSmallResolve"
+const OperationUserAdminJobList = "/api.user.v1.User/AdminJobList"
+const OperationUserAdminJobRun = "/api.user.v1.User/AdminJobRun"
 const OperationUserAdminLogin = "/api.user.v1.User/AdminLogin"
 const OperationUserAdminRewardList = "/api.user.v1.User/AdminRewardList"
+const OperationUserAdminRewardReasonList = "/api.user.v1.User/AdminRewardReasonList"
+const OperationUserAdminTransferList = "/api.user.v1.User/AdminTransferList"
 const OperationUserAdminUserBind = "/api.user.v1.User/AdminUserBind"
 const OperationUserAdminUserBindTwo = "/api.user.v1.User/AdminUserBindTwo"
 const OperationUserAdminUserList = "/api.user.v1.User/AdminUserList"
+const OperationUserAdminUserStatement = "/api.user.v1.User/AdminUserStatement"
 const OperationUserAdminWithdrawEth = "/api.user.v1.User/AdminWithdrawEth"
+const OperationUserAdminWithdrawList = "/api.user.v1.User/AdminWithdrawList"
+const OperationUserAdminWithdrawPause = "/api.user.v1.User/AdminWithdrawPause"
+const OperationUserAdminWithdrawReview = "/api.user.v1.User/AdminWithdrawReview"
 const OperationUserAllInfo = "/api.user.v1.User/AllInfo"
 const OperationUserAutoUpdateAllCard = "/api.user.v1.User/AutoUpdateAllCard"
 const OperationUserCardStatusHandle = "/api.user.v1.User/CardStatusHandle"
@@ -37,27 +50,56 @@ const OperationUserEmailGet = "/api.user.v1.User/EmailGet"
 const OperationUserOpenCardHandle = "/api.user.v1.User/OpenCardHandle"
 const OperationUserPullAllCard = "/api.user.v1.User/PullAllCard"
 const OperationUserRewardCardTwo = "/api.user.v1.User/RewardCardTwo"
+const OperationUserSetLockTransfer = "/api.user.v1.User/SetLockTransfer"
 const OperationUserSetUserCount = "/api.user.v1.User/SetUserCount"
 const OperationUserSetVipThree = "/api.user.v1.User/SetVipThree"
+const OperationUserTransfer = "/api.user.v1.User/Transfer"
 const OperationUserUpdateAllCard = "/api.user.v1.User/UpdateAllCard"
 const OperationUserUpdateAllCardOne = "/api.user.v1.User/UpdateAllCardOne"
 const OperationUserUpdateCanVip = "/api.user.v1.User/UpdateCanVip"
 const OperationUserUpdateUserInfoTo = "/api.user.v1.User/UpdateUserInfoTo"
+const OperationUserWithdraw = "/api.user.v1.User/Withdraw"
 
 type UserHTTPServer interface {
+	// AdminBalanceDriftList 余额对账差异，每晚按 reward、withdraw、transfer 记录重算余额
+	AdminBalanceDriftList(context.Context, *AdminBalanceDriftListRequest) (*AdminBalanceDriftListReply, error)
 	AdminCardTwoList(context.Context, *AdminCardTwoRequest) (*AdminCardTwoReply, error)
 	AdminCardTwoListNew(context.Context, *AdminCardTwoRequest) (*AdminCardTwoNewReply, error)
 	AdminConfig(context.Context, *AdminConfigRequest) (*AdminConfigReply, error)
 	AdminConfigUpdate(context.Context, *AdminConfigUpdateRequest) (*AdminConfigUpdateReply, error)
+	// AdminDepositReconcile 充值对账，链上 getUsersAmountByIndex 与库内充值记录比对
+	AdminDepositReconcile(context.Context, *AdminDepositReconcileRequest) (*AdminDepositReconcileReply, error)
+	// AdminDepositReplay 补入账，入账关闭期间只记录未加余额的充值
+	AdminDepositReplay(context.Context, *AdminDepositReplayRequest) (*AdminDepositReplayReply, error)
+	// AdminDepositSmallList 小额充值列表，低于最小充值金额的待累计和待审核记录
+	AdminDepositSmallList(context.Context, *AdminDepositSmallListRequest) (*AdminDepositSmallListReply, error)
+	// AdminDepositSmallResolve 小额充值处理，入账或驳回
+	AdminDepositSmallResolve(context.Context, *AdminDepositSmallResolveRequest) (*AdminDepositSmallResolveReply, error)
+	// AdminJobList 定时任务列表
+	AdminJobList(context.Context, *AdminJobListRequest) (*AdminJobListReply, error)
+	// AdminJobRun 手动执行定时任务
+	AdminJobRun(context.Context, *AdminJobRunRequest) (*AdminJobRunReply, error)
 	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
+	// AdminRewardReasonList 收益/流水原因和文案，后台按这里展示，不要写死编号
+	AdminRewardReasonList(context.Context, *AdminRewardReasonListRequest) (*AdminRewardReasonListReply, error)
+	// AdminTransferList 划转记录
+	AdminTransferList(context.Context, *AdminTransferListRequest) (*AdminTransferListReply, error)
 	// AdminUserBind 虚拟卡手动绑定，进处理队列
 	AdminUserBind(context.Context, *AdminUserBindRequest) (*AdminUserBindReply, error)
 	// AdminUserBindTwo 实体卡手动绑定，进处理队列
 	AdminUserBindTwo(context.Context, *AdminUserBindTwoRequest) (*AdminUserBindTwoReply, error)
 	AdminUserList(context.Context, *AdminUserListRequest) (*AdminUserListReply, error)
+	// AdminUserStatement 用户对账单，每笔收支按时间顺序带余额，导出 CSV 用 /api/admin_dhb/user_statement_csv
+	AdminUserStatement(context.Context, *AdminUserStatementRequest) (*AdminUserStatementReply, error)
 	// AdminWithdrawEth 提现
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
+	// AdminWithdrawList 提现列表
+	AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error)
+	// AdminWithdrawPause 提现队列暂停状态，热钱包余额不足时自动暂停
+	AdminWithdrawPause(context.Context, *AdminWithdrawPauseRequest) (*AdminWithdrawPauseReply, error)
+	// AdminWithdrawReview 提现审核，通过或驳回，驳回退回余额
+	AdminWithdrawReview(context.Context, *AdminWithdrawReviewRequest) (*AdminWithdrawReviewReply, error)
 	AllInfo(context.Context, *AllInfoRequest) (*AllInfoReply, error)
 	AutoUpdateAllCard(context.Context, *UpdateAllCardRequest) (*UpdateAllCardReply, error)
 	// CardStatusHandle 废弃
@@ -70,16 +112,22 @@ type UserHTTPServer interface {
 	PullAllCard(context.Context, *PullAllCardRequest) (*PullAllCardReply, error)
 	// RewardCardTwo 实体卡分红
 	RewardCardTwo(context.Context, *RewardCardTwoRequest) (*RewardCardTwoReply, error)
+	// SetLockTransfer 设置用户禁止划转，转出和收款都拒绝
+	SetLockTransfer(context.Context, *SetLockTransferRequest) (*SetLockTransferReply, error)
 	// SetUserCount 清除用户申请卡片次数，目前无用
 	SetUserCount(context.Context, *SetUserCountRequest) (*SetUserCountReply, error)
 	// SetVipThree 设置实体卡分红级别
 	SetVipThree(context.Context, *SetVipThreeRequest) (*SetVipThreeReply, error)
+	// Transfer 用户之间划转，send_body.idemKey 同一用户内唯一，重复提交返回第一次的结果
+	Transfer(context.Context, *TransferRequest) (*TransferReply, error)
 	UpdateAllCard(context.Context, *UpdateAllCardRequest) (*UpdateAllCardReply, error)
 	UpdateAllCardOne(context.Context, *UpdateAllCardRequest) (*UpdateAllCardReply, error)
 	// UpdateCanVip 设置用户客户端调整分红级别虚拟卡
 	UpdateCanVip(context.Context, *UpdateCanVipRequest) (*UpdateCanVipReply, error)
 	// UpdateUserInfoTo 废弃
 	UpdateUserInfoTo(context.Context, *UpdateUserInfoToRequest) (*UpdateUserInfoToReply, error)
+	// Withdraw 用户提现到自己的地址，按手续费配置扣费，超过审核金额的待审核
+	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
 }
 
 func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
@@ -87,9 +135,25 @@ func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r.GET("/api/admin_dhb/open_card_handle", _User_OpenCardHandle0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/card_status_handle", _User_CardStatusHandle0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit", _User_Deposit0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_reconcile", _User_AdminDepositReconcile0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/deposit_replay", _User_AdminDepositReplay0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_small_list", _User_AdminDepositSmallList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/deposit_small_resolve", _User_AdminDepositSmallResolve0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/job_list", _User_AdminJobList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/job_run", _User_AdminJobRun0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_list", _User_AdminWithdrawList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_review", _User_AdminWithdrawReview0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_pause", _User_AdminWithdrawPause0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw_eth", _User_AdminWithdrawEth0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/reward_card_two", _User_RewardCardTwo0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/reward_list", _User_AdminRewardList0_HTTP_Handler(srv))
+	r.POST("/api/app_server/transfer", _User_Transfer0_HTTP_Handler(srv))
+	r.POST("/api/app_server/withdraw", _User_Withdraw0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/transfer_list", _User_AdminTransferList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/set_lock_transfer", _User_SetLockTransfer0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/balance_drift_list", _User_AdminBalanceDriftList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/user_statement", _User_AdminUserStatement0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reward_reason_list", _User_AdminRewardReasonList0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/user_list", _User_AdminUserList0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/card_two_list", _User_AdminCardTwoList0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/card_two_list_new", _User_AdminCardTwoListNew0_HTTP_Handler(srv))
@@ -167,6 +231,189 @@ func _User_Deposit0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) erro
 	}
 }
 
+func _User_AdminDepositReconcile0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositReconcileRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminDepositReconcile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositReconcile(ctx, req.(*AdminDepositReconcileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositReconcileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminDepositReplay0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositReplayRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminDepositReplay)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositReplay(ctx, req.(*AdminDepositReplayRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositReplayReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminDepositSmallList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositSmallListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminDepositSmallList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositSmallList(ctx, req.(*AdminDepositSmallListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositSmallListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminDepositSmallResolve0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositSmallResolveRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminDepositSmallResolve)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositSmallResolve(ctx, req.(*AdminDepositSmallResolveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositSmallResolveReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminJobList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminJobListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminJobList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminJobList(ctx, req.(*AdminJobListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminJobListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminJobRun0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminJobRunRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminJobRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminJobRun(ctx, req.(*AdminJobRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminJobRunReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminWithdrawList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminWithdrawList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawList(ctx, req.(*AdminWithdrawListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminWithdrawReview0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawReviewRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminWithdrawReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReview(ctx, req.(*AdminWithdrawReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminWithdrawPause0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawPauseRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminWithdrawPause)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawPause(ctx, req.(*AdminWithdrawPauseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawPauseReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _User_AdminWithdrawEth0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AdminWithdrawEthRequest
@@ -224,6 +471,148 @@ func _User_AdminRewardList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Conte
 	}
 }
 
+func _User_Transfer0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TransferRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserTransfer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Transfer(ctx, req.(*TransferRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TransferReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Withdraw0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserWithdraw)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Withdraw(ctx, req.(*WithdrawRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminTransferList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminTransferListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminTransferList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminTransferList(ctx, req.(*AdminTransferListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminTransferListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_SetLockTransfer0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetLockTransferRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserSetLockTransfer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetLockTransfer(ctx, req.(*SetLockTransferRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetLockTransferReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminBalanceDriftList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminBalanceDriftListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminBalanceDriftList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminBalanceDriftList(ctx, req.(*AdminBalanceDriftListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminBalanceDriftListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminUserStatement0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserStatementRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminUserStatement)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserStatement(ctx, req.(*AdminUserStatementRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserStatementReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminRewardReasonList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRewardReasonListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminRewardReasonList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRewardReasonList(ctx, req.(*AdminRewardReasonListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminRewardReasonListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _User_AdminUserList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AdminUserListRequest
@@ -591,16 +980,29 @@ func _User_AutoUpdateAllCard0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Con
 }
 
 type UserHTTPClient interface {
+	AdminBalanceDriftList(ctx context.Context, req *AdminBalanceDriftListRequest, opts ...http.CallOption) (rsp *AdminBalanceDriftListReply, err error)
 	AdminCardTwoList(ctx context.Context, req *AdminCardTwoRequest, opts ...http.CallOption) (rsp *AdminCardTwoReply, err error)
 	AdminCardTwoListNew(ctx context.Context, req *AdminCardTwoRequest, opts ...http.CallOption) (rsp *AdminCardTwoNewReply, err error)
 	AdminConfig(ctx context.Context, req *AdminConfigRequest, opts ...http.CallOption) (rsp *AdminConfigReply, err error)
 	AdminConfigUpdate(ctx context.Context, req *AdminConfigUpdateRequest, opts ...http.CallOption) (rsp *AdminConfigUpdateReply, err error)
+	AdminDepositReconcile(ctx context.Context, req *AdminDepositReconcileRequest, opts ...http.CallOption) (rsp *AdminDepositReconcileReply, err error)
+	AdminDepositReplay(ctx context.Context, req *AdminDepositReplayRequest, opts ...http.CallOption) (rsp *AdminDepositReplayReply, err error)
+	AdminDepositSmallList(ctx context.Context, req *AdminDepositSmallListRequest, opts ...http.CallOption) (rsp *AdminDepositSmallListReply, err error)
+	AdminDepositSmallResolve(ctx context.Context, req *AdminDepositSmallResolveRequest, opts ...http.CallOption) (rsp *AdminDepositSmallResolveReply, err error)
+	AdminJobList(ctx context.Context, req *AdminJobListRequest, opts ...http.CallOption) (rsp *AdminJobListReply, err error)
+	AdminJobRun(ctx context.Context, req *AdminJobRunRequest, opts ...http.CallOption) (rsp *AdminJobRunReply, err error)
 	AdminLogin(ctx context.Context, req *AdminLoginRequest, opts ...http.CallOption) (rsp *AdminLoginReply, err error)
 	AdminRewardList(ctx context.Context, req *AdminRewardListRequest, opts ...http.CallOption) (rsp *AdminRewardListReply, err error)
+	AdminRewardReasonList(ctx context.Context, req *AdminRewardReasonListRequest, opts ...http.CallOption) (rsp *AdminRewardReasonListReply, err error)
+	AdminTransferList(ctx context.Context, req *AdminTransferListRequest, opts ...http.CallOption) (rsp *AdminTransferListReply, err error)
 	AdminUserBind(ctx context.Context, req *AdminUserBindRequest, opts ...http.CallOption) (rsp *AdminUserBindReply, err error)
 	AdminUserBindTwo(ctx context.Context, req *AdminUserBindTwoRequest, opts ...http.CallOption) (rsp *AdminUserBindTwoReply, err error)
 	AdminUserList(ctx context.Context, req *AdminUserListRequest, opts ...http.CallOption) (rsp *AdminUserListReply, err error)
+	AdminUserStatement(ctx context.Context, req *AdminUserStatementRequest, opts ...http.CallOption) (rsp *AdminUserStatementReply, err error)
 	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
+	AdminWithdrawList(ctx context.Context, req *AdminWithdrawListRequest, opts ...http.CallOption) (rsp *AdminWithdrawListReply, err error)
+	AdminWithdrawPause(ctx context.Context, req *AdminWithdrawPauseRequest, opts ...http.CallOption) (rsp *AdminWithdrawPauseReply, err error)
+	AdminWithdrawReview(ctx context.Context, req *AdminWithdrawReviewRequest, opts ...http.CallOption) (rsp *AdminWithdrawReviewReply, err error)
 	AllInfo(ctx context.Context, req *AllInfoRequest, opts ...http.CallOption) (rsp *AllInfoReply, err error)
 	AutoUpdateAllCard(ctx context.Context, req *UpdateAllCardRequest, opts ...http.CallOption) (rsp *UpdateAllCardReply, err error)
 	CardStatusHandle(ctx context.Context, req *CardStatusHandleRequest, opts ...http.CallOption) (rsp *CardStatusHandleReply, err error)
@@ -609,12 +1011,15 @@ type UserHTTPClient interface {
 	OpenCardHandle(ctx context.Context, req *OpenCardHandleRequest, opts ...http.CallOption) (rsp *OpenCardHandleReply, err error)
 	PullAllCard(ctx context.Context, req *PullAllCardRequest, opts ...http.CallOption) (rsp *PullAllCardReply, err error)
 	RewardCardTwo(ctx context.Context, req *RewardCardTwoRequest, opts ...http.CallOption) (rsp *RewardCardTwoReply, err error)
+	SetLockTransfer(ctx context.Context, req *SetLockTransferRequest, opts ...http.CallOption) (rsp *SetLockTransferReply, err error)
 	SetUserCount(ctx context.Context, req *SetUserCountRequest, opts ...http.CallOption) (rsp *SetUserCountReply, err error)
 	SetVipThree(ctx context.Context, req *SetVipThreeRequest, opts ...http.CallOption) (rsp *SetVipThreeReply, err error)
+	Transfer(ctx context.Context, req *TransferRequest, opts ...http.CallOption) (rsp *TransferReply, err error)
 	UpdateAllCard(ctx context.Context, req *UpdateAllCardRequest, opts ...http.CallOption) (rsp *UpdateAllCardReply, err error)
 	UpdateAllCardOne(ctx context.Context, req *UpdateAllCardRequest, opts ...http.CallOption) (rsp *UpdateAllCardReply, err error)
 	UpdateCanVip(ctx context.Context, req *UpdateCanVipRequest, opts ...http.CallOption) (rsp *UpdateCanVipReply, err error)
 	UpdateUserInfoTo(ctx context.Context, req *UpdateUserInfoToRequest, opts ...http.CallOption) (rsp *UpdateUserInfoToReply, err error)
+	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
 }
 
 type UserHTTPClientImpl struct {
@@ -625,6 +1030,19 @@ func NewUserHTTPClient(client *http.Client) UserHTTPClient {
 	return &UserHTTPClientImpl{client}
 }
 
+func (c *UserHTTPClientImpl) AdminBalanceDriftList(ctx context.Context, in *AdminBalanceDriftListRequest, opts ...http.CallOption) (*AdminBalanceDriftListReply, error) {
+	var out AdminBalanceDriftListReply
+	pattern := "/api/admin_dhb/balance_drift_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminBalanceDriftList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AdminCardTwoList(ctx context.Context, in *AdminCardTwoRequest, opts ...http.CallOption) (*AdminCardTwoReply, error) {
 	var out AdminCardTwoReply
 	pattern := "/api/admin_dhb/card_two_list"
@@ -677,6 +1095,84 @@ func (c *UserHTTPClientImpl) AdminConfigUpdate(ctx context.Context, in *AdminCon
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminDepositReconcile(ctx context.Context, in *AdminDepositReconcileRequest, opts ...http.CallOption) (*AdminDepositReconcileReply, error) {
+	var out AdminDepositReconcileReply
+	pattern := "/api/admin_dhb/deposit_reconcile"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminDepositReconcile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminDepositReplay(ctx context.Context, in *AdminDepositReplayRequest, opts ...http.CallOption) (*AdminDepositReplayReply, error) {
+	var out AdminDepositReplayReply
+	pattern := "/api/admin_dhb/deposit_replay"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminDepositReplay))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminDepositSmallList(ctx context.Context, in *AdminDepositSmallListRequest, opts ...http.CallOption) (*AdminDepositSmallListReply, error) {
+	var out AdminDepositSmallListReply
+	pattern := "/api/admin_dhb/deposit_small_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminDepositSmallList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminDepositSmallResolve(ctx context.Context, in *AdminDepositSmallResolveRequest, opts ...http.CallOption) (*AdminDepositSmallResolveReply, error) {
+	var out AdminDepositSmallResolveReply
+	pattern := "/api/admin_dhb/deposit_small_resolve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminDepositSmallResolve))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminJobList(ctx context.Context, in *AdminJobListRequest, opts ...http.CallOption) (*AdminJobListReply, error) {
+	var out AdminJobListReply
+	pattern := "/api/admin_dhb/job_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminJobList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminJobRun(ctx context.Context, in *AdminJobRunRequest, opts ...http.CallOption) (*AdminJobRunReply, error) {
+	var out AdminJobRunReply
+	pattern := "/api/admin_dhb/job_run"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminJobRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...http.CallOption) (*AdminLoginReply, error) {
 	var out AdminLoginReply
 	pattern := "/api/admin_dhb/login"
@@ -703,6 +1199,32 @@ func (c *UserHTTPClientImpl) AdminRewardList(ctx context.Context, in *AdminRewar
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminRewardReasonList(ctx context.Context, in *AdminRewardReasonListRequest, opts ...http.CallOption) (*AdminRewardReasonListReply, error) {
+	var out AdminRewardReasonListReply
+	pattern := "/api/admin_dhb/reward_reason_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminRewardReasonList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminTransferList(ctx context.Context, in *AdminTransferListRequest, opts ...http.CallOption) (*AdminTransferListReply, error) {
+	var out AdminTransferListReply
+	pattern := "/api/admin_dhb/transfer_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminTransferList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AdminUserBind(ctx context.Context, in *AdminUserBindRequest, opts ...http.CallOption) (*AdminUserBindReply, error) {
 	var out AdminUserBindReply
 	pattern := "/api/admin_dhb/admin_user_bind"
@@ -742,6 +1264,19 @@ func (c *UserHTTPClientImpl) AdminUserList(ctx context.Context, in *AdminUserLis
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminUserStatement(ctx context.Context, in *AdminUserStatementRequest, opts ...http.CallOption) (*AdminUserStatementReply, error) {
+	var out AdminUserStatementReply
+	pattern := "/api/admin_dhb/user_statement"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminUserStatement))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...http.CallOption) (*AdminWithdrawEthReply, error) {
 	var out AdminWithdrawEthReply
 	pattern := "/api/admin_dhb/withdraw_eth"
@@ -755,6 +1290,45 @@ func (c *UserHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWith
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...http.CallOption) (*AdminWithdrawListReply, error) {
+	var out AdminWithdrawListReply
+	pattern := "/api/admin_dhb/withdraw_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminWithdrawList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminWithdrawPause(ctx context.Context, in *AdminWithdrawPauseRequest, opts ...http.CallOption) (*AdminWithdrawPauseReply, error) {
+	var out AdminWithdrawPauseReply
+	pattern := "/api/admin_dhb/withdraw_pause"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminWithdrawPause))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminWithdrawReview(ctx context.Context, in *AdminWithdrawReviewRequest, opts ...http.CallOption) (*AdminWithdrawReviewReply, error) {
+	var out AdminWithdrawReviewReply
+	pattern := "/api/admin_dhb/withdraw_review"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminWithdrawReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AllInfo(ctx context.Context, in *AllInfoRequest, opts ...http.CallOption) (*AllInfoReply, error) {
 	var out AllInfoReply
 	pattern := "/api/admin_dhb/all_info"
@@ -859,6 +1433,19 @@ func (c *UserHTTPClientImpl) RewardCardTwo(ctx context.Context, in *RewardCardTw
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) SetLockTransfer(ctx context.Context, in *SetLockTransferRequest, opts ...http.CallOption) (*SetLockTransferReply, error) {
+	var out SetLockTransferReply
+	pattern := "/api/admin_dhb/set_lock_transfer"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserSetLockTransfer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) SetUserCount(ctx context.Context, in *SetUserCountRequest, opts ...http.CallOption) (*SetUserCountReply, error) {
 	var out SetUserCountReply
 	pattern := "/api/admin_dhb/set_user_count"
@@ -885,6 +1472,19 @@ func (c *UserHTTPClientImpl) SetVipThree(ctx context.Context, in *SetVipThreeReq
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) Transfer(ctx context.Context, in *TransferRequest, opts ...http.CallOption) (*TransferReply, error) {
+	var out TransferReply
+	pattern := "/api/app_server/transfer"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserTransfer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) UpdateAllCard(ctx context.Context, in *UpdateAllCardRequest, opts ...http.CallOption) (*UpdateAllCardReply, error) {
 	var out UpdateAllCardReply
 	pattern := "/api/admin_dhb/update_all_card"
@@ -936,3 +1536,16 @@ func (c *UserHTTPClientImpl) UpdateUserInfoTo(ctx context.Context, in *UpdateUse
 	}
 	return &out, err
 }
+
+func (c *UserHTTPClientImpl) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...http.CallOption) (*WithdrawReply, error) {
+	var out WithdrawReply
+	pattern := "/api/app_server/withdraw"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserWithdraw))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, chain *conf.Chain, scheduler *conf.Scheduler, notify *conf.Notify, vendors *conf.Vendors, logger log.Logger) (*kratos.App, func(), error) {
 	grpcServer := server.NewGRPCServer(confServer, logger)
 	db := data.NewDB(confData)
 	client := data.NewRedis(confData)
@@ -33,11 +33,37 @@ func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logg
 	}
 	userRepo := data.NewUserRepo(dataData, logger)
 	transaction := data.NewTransaction(dataData)
-	userUseCase := biz.NewUserUseCase(userRepo, transaction, logger)
-	userService := service.NewUserService(userUseCase, logger, auth)
+	isPayConfig, err := service.NewISPayConfig(vendors)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	isPayProvider := biz.NewISPayProvider(isPayConfig)
+	interlaceConfig, err := service.NewInterlaceConfig(vendors)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	interlaceProvider := biz.NewInterlaceProvider(interlaceConfig)
+	cardProviders := biz.NewCardProviders(isPayProvider, interlaceProvider)
+	userUseCase := biz.NewUserUseCase(userRepo, transaction, cardProviders, interlaceProvider, logger)
+	backend, cleanup2 := service.NewChainPool(chain)
+	depositSources, cleanup3 := service.NewDepositSources(chain)
+	schedulerScheduler := service.NewScheduler(scheduler, client)
+	signer, err := service.NewSigner(chain, scheduler)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	notifier := service.NewNotifier(notify)
+	userService := service.NewUserService(userUseCase, logger, auth, chain, backend, depositSources, scheduler, schedulerScheduler, signer, notifier)
 	httpServer := server.NewHTTPServer(confServer, userService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	app := newApp(logger, grpcServer, httpServer, schedulerScheduler)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"cardbinance/internal/pkg/signer"
+)
+
+// 本地签名服务桩，配合 signer.type: remote 联调，私钥来自环境变量，不能用于生产
+var (
+	addr     string
+	keyEnv   string
+	tokenEnv string
+)
+
+func init() {
+	flag.StringVar(&addr, "addr", "127.0.0.1:8601", "listen address")
+	flag.StringVar(&keyEnv, "key_env", "WITHDRAW_STUB_KEY", "env holding the hex private key")
+	flag.StringVar(&tokenEnv, "token_env", "WITHDRAW_STUB_TOKEN", "env holding the bearer token, empty to skip auth")
+}
+
+func main() {
+	flag.Parse()
+
+	s, err := signer.NewEnv(keyEnv)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println("签名服务桩", addr, s.Address().Hex())
+	if err = http.ListenAndServe(addr, signer.NewRemoteStub(s, os.Getenv(tokenEnv))); err != nil {
+		panic(err)
+	}
+}
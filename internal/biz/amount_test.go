@@ -0,0 +1,149 @@
+package biz
+
+import (
+	"math/big"
+	"testing"
+)
+
+func bigInt(t *testing.T, s string) *big.Int {
+	t.Helper()
+
+	res, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("bad big int %q", s)
+	}
+
+	return res
+}
+
+func TestScaleAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount string
+		from   uint32
+		to     uint32
+		want   string
+	}{
+		{"整数到18位", "12", 0, 18, "12000000000000000000"},
+		{"整数到6位", "12", 0, 6, "12000000"},
+		{"6位到18位", "1500000", 6, 18, "1500000000000000000"},
+		{"18位到6位", "1500000000000000000", 18, 6, "1500000"},
+		{"18位到6位截断", "1234567890123456789", 18, 6, "1234567"},
+		{"18位到整数截断", "9999999999999999999", 18, 0, "9"},
+		{"6位到整数截断", "999999", 6, 0, "0"},
+		{"位数相同", "123", 6, 6, "123"},
+		{"超过int64", "123456789012345678901234567890", 18, 18, "123456789012345678901234567890"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ScaleAmount(bigInt(t, tt.amount), tt.from, tt.to)
+			if tt.want != got.String() {
+				t.Fatalf("ScaleAmount(%s, %d, %d) = %s, want %s", tt.amount, tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+
+	if 0 != ScaleAmount(nil, 0, 18).Sign() {
+		t.Fatal("ScaleAmount(nil) should be 0")
+	}
+}
+
+func TestWeiToDecimal(t *testing.T) {
+	tests := []struct {
+		name     string
+		wei      string
+		decimals uint32
+		want     string
+	}{
+		{"18位整数", "12000000000000000000", 18, "12"},
+		{"18位小数", "1500000000000000000", 18, "1.5"},
+		{"18位最小单位", "1", 18, "0.000000000000000001"},
+		{"6位整数", "12000000", 6, "12"},
+		{"6位小数", "1234567", 6, "1.234567"},
+		{"6位最小单位", "1", 6, "0.000001"},
+		{"0位", "42", 0, "42"},
+		{"负数", "-1500000", 6, "-1.5"},
+		{"超过int64", "123456789012345678901234567890", 18, "123456789012.34567890123456789"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WeiToDecimal(bigInt(t, tt.wei), tt.decimals)
+			if tt.want != got {
+				t.Fatalf("WeiToDecimal(%s, %d) = %s, want %s", tt.wei, tt.decimals, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalToWei(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		decimals uint32
+		want     string
+	}{
+		{"18位整数", "12", 18, "12000000000000000000"},
+		{"18位小数", "1.5", 18, "1500000000000000000"},
+		{"6位小数", "1.234567", 6, "1234567"},
+		{"6位截断", "1.23456789", 6, "1234567"},
+		{"格式错误", "abc", 6, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DecimalToWei(tt.value, tt.decimals)
+			if tt.want != got.String() {
+				t.Fatalf("DecimalToWei(%s, %d) = %s, want %s", tt.value, tt.decimals, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEthUserRecordCreditAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		record *EthUserRecord
+		want   string
+	}{
+		{"旧记录按整数个", &EthUserRecord{Amount: "100000000000000000000", AmountTwo: 100}, "100"},
+		{"18位代币", &EthUserRecord{Amount: "10500000000000000000", AmountTwo: 10, Decimals: 18}, "10.5"},
+		{"18位代币最小单位", &EthUserRecord{Amount: "1", Decimals: 18}, "0.000000000000000001"},
+		{"6位代币", &EthUserRecord{Amount: "10500000", AmountTwo: 10, Decimals: 6}, "10.5"},
+		{"6位代币最小单位", &EthUserRecord{Amount: "1", Decimals: 6}, "0.000001"},
+		{"24位代币超出精度截断", &EthUserRecord{Amount: "1234567890123456789012345", Decimals: 24}, "1.23456789012345678901"},
+		{"金额格式错误", &EthUserRecord{Amount: "x", Decimals: 18}, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.record.CreditAmount()
+			if tt.want != got.String() {
+				t.Fatalf("CreditAmount() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEthUserRecordWei(t *testing.T) {
+	tests := []struct {
+		name     string
+		record   *EthUserRecord
+		decimals uint32
+		want     string
+	}{
+		{"旧记录到18位", &EthUserRecord{AmountTwo: 10}, 18, "10000000000000000000"},
+		{"6位到18位", &EthUserRecord{Amount: "10500000", Decimals: 6}, 18, "10500000000000000000"},
+		{"18位到6位截断", &EthUserRecord{Amount: "10500000999999999999", Decimals: 18}, 6, "10500000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.record.wei(tt.decimals)
+			if tt.want != got.String() {
+				t.Fatalf("wei(%d) = %s, want %s", tt.decimals, got, tt.want)
+			}
+		})
+	}
+}
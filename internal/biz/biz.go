@@ -6,7 +6,7 @@ import (
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewUserUseCase)
+var ProviderSet = wire.NewSet(NewUserUseCase, NewCardProviders, NewISPayProvider, NewInterlaceProvider)
 
 // Transaction 新增事务接口方法
 type Transaction interface {
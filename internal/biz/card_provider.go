@@ -0,0 +1,451 @@
+package biz
+
+import (
+	"cardbinance/internal/pkg/money"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 发卡渠道
+const (
+	CardProviderISPay     = "ispay"
+	CardProviderInterlace = "interlace"
+)
+
+// 持卡人状态，渠道原值统一成这几个
+const (
+	CardholderActive  = "active"
+	CardholderPending = "pending"
+	CardholderFailed  = "failed"
+)
+
+var ErrCardProviderUnsupported = errors.New(500, "CARD_PROVIDER_UNSUPPORTED", "发卡渠道不支持该操作")
+
+// CardholderReq 创建持卡人，个人信息取 User，ProductId 为渠道的产品号或 BIN
+type CardholderReq struct {
+	User        *User
+	ProductId   string
+	AccountId   string
+	IdFrontFile string // 渠道文件 id，证件正面
+	SelfieFile  string // 渠道文件 id，手持照
+}
+
+// ProviderCardholder 渠道返回的持卡人
+type ProviderCardholder struct {
+	ID     string
+	Status string
+}
+
+// IssueCardReq 开卡
+type IssueCardReq struct {
+	CardholderId string
+	ProductId    string
+	Amount       money.Money
+}
+
+// ProviderCard 渠道返回的卡，Card.Status 为渠道原值（ACTIVE、PENDING 等）
+type ProviderCard struct {
+	Card    *Card
+	OrderId string
+	Pan     string
+}
+
+// CardProvider 发卡渠道，每家一个实现，渠道没有的操作返回 ErrCardProviderUnsupported。
+// TransferOut 的 requestId 由调用方按业务生成，同一笔重试要传同一个
+type CardProvider interface {
+	Name() string
+	CreateCardholder(ctx context.Context, req *CardholderReq) (string, error)
+	GetCardholder(ctx context.Context, cardholderId string, productId string) (*ProviderCardholder, error)
+	IssueCard(ctx context.Context, req *IssueCardReq) (*ProviderCard, error)
+	GetCard(ctx context.Context, cardId string) (*ProviderCard, error)
+	ListCards(ctx context.Context, page int, limit int) ([]*ProviderCard, error)
+	GetBalance(ctx context.Context, cardId string) (money.Money, error)
+	TransferIn(ctx context.Context, cardId string, amount money.Money) error
+	TransferOut(ctx context.Context, cardId string, amount money.Money, requestId string) error
+	Freeze(ctx context.Context, cardId string, freeze bool) error
+}
+
+// CardProviders 渠道名到实现
+type CardProviders map[string]CardProvider
+
+func NewCardProviders(ispay *ISPayProvider, interlace *InterlaceProvider) CardProviders {
+	return CardProviders{
+		CardProviderISPay:     ispay,
+		CardProviderInterlace: interlace,
+	}
+}
+
+// cardProvider 按卡产品选渠道，配置 card_product_provider 格式 "产品:渠道,产品:渠道"，
+// 没配置的产品用 fallback，即调用处原来对接的渠道
+func (uuc *UserUseCase) cardProvider(productId string, fallback string) CardProvider {
+	name := fallback
+
+	configs, _ := uuc.repo.GetConfigByKeys("card_product_provider")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "card_product_provider" != vConfig.KeyName {
+				continue
+			}
+
+			for _, v := range strings.Split(vConfig.Value, ",") {
+				tmp := strings.SplitN(strings.TrimSpace(v), ":", 2)
+				if 2 == len(tmp) && productId == tmp[0] {
+					name = tmp[1]
+				}
+			}
+		}
+	}
+
+	if p, ok := uuc.providers[name]; ok {
+		return p
+	}
+
+	fmt.Println("发卡渠道未配置，使用默认", productId, name, fallback)
+	return uuc.providers[fallback]
+}
+
+// cardProviderByCard 已入库的卡按卡 BIN 选渠道，没入库的用 fallback
+func (uuc *UserUseCase) cardProviderByCard(ctx context.Context, cardId string, fallback string) CardProvider {
+	bin := ""
+	card, err := uuc.repo.GetCardByCardId(ctx, cardId)
+	if nil == err && nil != card {
+		bin = card.Bin
+	}
+
+	return uuc.cardProvider(bin, fallback)
+}
+
+// getCardReserveProduct 储备卡的产品，配置 card_reserve_product，拉取储备卡时按它选渠道
+func (uuc *UserUseCase) getCardReserveProduct() string {
+	var (
+		configs []*Config
+	)
+
+	product := ""
+	configs, _ = uuc.repo.GetConfigByKeys("card_reserve_product")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "card_reserve_product" == vConfig.KeyName {
+				product = vConfig.Value
+			}
+		}
+	}
+
+	return product
+}
+
+// ISPayConfig 渠道地址和商户信息，按 conf.Vendors 当前环境取
+type ISPayConfig struct {
+	BaseURL       string // 开卡、卡信息，到 /prod-api
+	HolderBaseURL string // 持卡人查询
+	MerchantId    string
+	SignKey       string
+	Timeout       time.Duration // 0 不限制
+}
+
+// InterlaceConfig 渠道地址和凭证，按 conf.Vendors 当前环境取
+type InterlaceConfig struct {
+	BaseURL       string // v3
+	BaseURLV1     string // v1，目前只有账户列表用
+	ClientId      string
+	ClientSecret  string
+	AccountId     string
+	Timeout       time.Duration
+	UploadTimeout time.Duration
+}
+
+// ISPayProvider 虚拟卡，持卡人由回调创建，余额和划转不走接口
+type ISPayProvider struct {
+	conf *ISPayConfig
+}
+
+func NewISPayProvider(c *ISPayConfig) *ISPayProvider {
+	return &ISPayProvider{
+		conf: c,
+	}
+}
+
+func (p *ISPayProvider) Name() string {
+	return CardProviderISPay
+}
+
+func (p *ISPayProvider) CreateCardholder(ctx context.Context, req *CardholderReq) (string, error) {
+	return "", ErrCardProviderUnsupported
+}
+
+func (p *ISPayProvider) GetCardholder(ctx context.Context, cardholderId string, productId string) (*ProviderCardholder, error) {
+	holderId, err := strconv.ParseUint(cardholderId, 10, 64)
+	if nil != err {
+		return nil, err
+	}
+
+	product, err := strconv.ParseUint(productId, 10, 64)
+	if nil != err {
+		return nil, err
+	}
+
+	res, err := p.QueryCardHolderWithSign(holderId, product)
+	if nil != err {
+		return nil, err
+	}
+	if nil == res || 200 != res.Code {
+		return nil, fmt.Errorf("ispay: query cardholder %v", res)
+	}
+
+	status := CardholderFailed
+	if CardholderActive == res.Data.Status || CardholderPending == res.Data.Status {
+		status = res.Data.Status
+	}
+
+	return &ProviderCardholder{
+		ID:     res.Data.HolderId,
+		Status: status,
+	}, nil
+}
+
+func (p *ISPayProvider) IssueCard(ctx context.Context, req *IssueCardReq) (*ProviderCard, error) {
+	holderId, err := strconv.ParseUint(req.CardholderId, 10, 64)
+	if nil != err {
+		return nil, err
+	}
+
+	product, err := strconv.ParseUint(req.ProductId, 10, 64)
+	if nil != err {
+		return nil, err
+	}
+
+	// 渠道金额只收整数，有小数的不能截断
+	amount, err := req.Amount.Uint64()
+	if nil != err {
+		return nil, err
+	}
+
+	res, err := p.CreateCardRequestWithSign(amount, holderId, product)
+	if nil != err {
+		return nil, err
+	}
+	if nil == res || 200 != res.Code {
+		return nil, fmt.Errorf("ispay: create card %v", res)
+	}
+
+	return &ProviderCard{
+		Card: &Card{
+			CardID:       res.Data.CardID,
+			CardholderID: req.CardholderId,
+			Status:       res.Data.CardStatus,
+		},
+		OrderId: res.Data.CardOrderID,
+	}, nil
+}
+
+func (p *ISPayProvider) GetCard(ctx context.Context, cardId string) (*ProviderCard, error) {
+	res, err := p.GetCardInfoRequestWithSign(cardId)
+	if nil != err {
+		return nil, err
+	}
+	if nil == res || 200 != res.Code {
+		return nil, fmt.Errorf("ispay: card info %v", res)
+	}
+
+	return &ProviderCard{
+		Card: &Card{
+			CardID:       res.Data.CardID,
+			CardholderID: res.Data.Holder.HolderID,
+			Status:       res.Data.CardStatus,
+		},
+		Pan: res.Data.Pan,
+	}, nil
+}
+
+func (p *ISPayProvider) ListCards(ctx context.Context, page int, limit int) ([]*ProviderCard, error) {
+	return nil, ErrCardProviderUnsupported
+}
+
+func (p *ISPayProvider) GetBalance(ctx context.Context, cardId string) (money.Money, error) {
+	return money.Zero(), ErrCardProviderUnsupported
+}
+
+func (p *ISPayProvider) TransferIn(ctx context.Context, cardId string, amount money.Money) error {
+	return ErrCardProviderUnsupported
+}
+
+func (p *ISPayProvider) TransferOut(ctx context.Context, cardId string, amount money.Money, requestId string) error {
+	return ErrCardProviderUnsupported
+}
+
+func (p *ISPayProvider) Freeze(ctx context.Context, cardId string, freeze bool) error {
+	return ErrCardProviderUnsupported
+}
+
+// InterlaceProvider 实体卡和新虚拟卡，卡片由渠道后台发出后同步过来
+type InterlaceProvider struct {
+	conf *InterlaceConfig
+}
+
+func NewInterlaceProvider(c *InterlaceConfig) *InterlaceProvider {
+	return &InterlaceProvider{
+		conf: c,
+	}
+}
+
+func (p *InterlaceProvider) Name() string {
+	return CardProviderInterlace
+}
+
+func (p *InterlaceProvider) CreateCardholder(ctx context.Context, req *CardholderReq) (string, error) {
+	accountId := req.AccountId
+	if "" == accountId {
+		accountId = p.conf.AccountId
+	}
+
+	u := req.User
+	return p.InterlaceCreateCardholderMOR(
+		ctx,
+		req.ProductId,
+		accountId,
+		u.Email,
+		u.FirstName,
+		u.LastName,
+		u.BirthDate,
+		u.Gender,
+		u.CountryCode,
+		u.IdCard,
+		u.IdType,
+		InterlaceAddress{
+			AddressLine1: u.Street,
+			City:         u.City,
+			State:        u.State,
+			Country:      u.Country,
+			PostalCode:   u.PostalCode,
+		},
+		req.IdFrontFile,
+		req.SelfieFile,
+		u.Phone,
+		u.PhoneCountryCode,
+	)
+}
+
+func (p *InterlaceProvider) GetCardholder(ctx context.Context, cardholderId string, productId string) (*ProviderCardholder, error) {
+	return nil, ErrCardProviderUnsupported
+}
+
+func (p *InterlaceProvider) IssueCard(ctx context.Context, req *IssueCardReq) (*ProviderCard, error) {
+	return nil, ErrCardProviderUnsupported
+}
+
+// GetCard 没有这张卡返回 nil
+func (p *InterlaceProvider) GetCard(ctx context.Context, cardId string) (*ProviderCard, error) {
+	cards, _, err := p.InterlaceListCards(ctx, &InterlaceListCardsReq{
+		AccountId: p.conf.AccountId,
+		CardId:    cardId,
+		Page:      1,
+		Limit:     10,
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	for _, v := range cards {
+		if cardId == v.ID {
+			return toProviderCard(v), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (p *InterlaceProvider) ListCards(ctx context.Context, page int, limit int) ([]*ProviderCard, error) {
+	cards, _, err := p.InterlaceListCards(ctx, &InterlaceListCardsReq{
+		AccountId: p.conf.AccountId,
+		Page:      page,
+		Limit:     limit,
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	res := make([]*ProviderCard, 0, len(cards))
+	for _, v := range cards {
+		res = append(res, toProviderCard(v))
+	}
+
+	return res, nil
+}
+
+func (p *InterlaceProvider) GetBalance(ctx context.Context, cardId string) (money.Money, error) {
+	res, err := p.InterlaceGetCardSummary(ctx, p.conf.AccountId, cardId)
+	if nil != err {
+		return money.Zero(), err
+	}
+	if nil == res {
+		return money.Zero(), fmt.Errorf("interlace: card summary empty")
+	}
+
+	return money.Parse(res.Data.Balance.Available)
+}
+
+func (p *InterlaceProvider) TransferIn(ctx context.Context, cardId string, amount money.Money) error {
+	return ErrCardProviderUnsupported
+}
+
+// TransferOut 卡余额划回账户，requestId 作渠道的幂等号，同一笔重试时渠道不会重复划转。
+// 渠道返回失败时报错，处理中的只打印
+func (p *InterlaceProvider) TransferOut(ctx context.Context, cardId string, amount money.Money, requestId string) error {
+	data, err := p.InterlaceCardTransferOut(ctx, &InterlaceCardTransferOutReq{
+		AccountId:           p.conf.AccountId,
+		CardId:              cardId,
+		ClientTransactionId: requestId,
+		Amount:              amount.String(), // 字符串
+	})
+	if nil != err {
+		return err
+	}
+	if nil == data {
+		return fmt.Errorf("interlace: transfer out empty")
+	}
+
+	if 3 != data.Type {
+		return fmt.Errorf("interlace: transfer out type %d", data.Type)
+	}
+
+	if "CLOSED" != data.Status {
+		fmt.Println("out status err", cardId, data)
+	}
+
+	if "FAIL" == data.Status {
+		return fmt.Errorf("interlace: transfer out fail %v", data)
+	}
+
+	return nil
+}
+
+func (p *InterlaceProvider) Freeze(ctx context.Context, cardId string, freeze bool) error {
+	return ErrCardProviderUnsupported
+}
+
+// toProviderCard 创建时间解析失败时 InterlaceCreateTime 为0
+func toProviderCard(ic *InterlaceCard) *ProviderCard {
+	createTime, _ := strconv.ParseInt(ic.CreateTime, 10, 64)
+	return &ProviderCard{
+		Card: &Card{
+			CardID:              ic.ID,
+			AccountID:           ic.AccountID,
+			CardholderID:        ic.CardholderID,
+			BalanceID:           ic.BalanceID,
+			BudgetID:            ic.BudgetID,
+			ReferenceID:         ic.ReferenceID,
+			UserName:            ic.UserName,
+			Currency:            ic.Currency,
+			Bin:                 ic.Bin,
+			Status:              ic.Status,
+			CardMode:            ic.CardMode,
+			Label:               ic.Label,
+			CardLastFour:        ic.CardLastFour,
+			InterlaceCreateTime: createTime, // 毫秒时间戳
+		},
+	}
+}
@@ -0,0 +1,169 @@
+package biz
+
+import (
+	"cardbinance/internal/pkg/money"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// fakeCardProvider 按页返回储备卡，其余操作不支持
+type fakeCardProvider struct {
+	CardProvider
+
+	name  string
+	pages [][]*ProviderCard
+	calls int
+}
+
+func (p *fakeCardProvider) Name() string {
+	return p.name
+}
+
+func (p *fakeCardProvider) ListCards(ctx context.Context, page int, limit int) ([]*ProviderCard, error) {
+	p.calls++
+	if nil == p.pages {
+		return nil, ErrCardProviderUnsupported
+	}
+
+	if page > len(p.pages) {
+		return []*ProviderCard{}, nil
+	}
+
+	return p.pages[page-1], nil
+}
+
+type cardRepo struct {
+	UserRepo
+
+	configs map[string]string
+	cards   map[string]*Card
+}
+
+func (r *cardRepo) GetConfigByKeys(keys ...string) ([]*Config, error) {
+	res := make([]*Config, 0)
+	for _, v := range keys {
+		if value, ok := r.configs[v]; ok {
+			res = append(res, &Config{KeyName: v, Value: value})
+		}
+	}
+
+	return res, nil
+}
+
+func (r *cardRepo) GetCardByCardId(ctx context.Context, cardId string) (*Card, error) {
+	return r.cards[cardId], nil
+}
+
+func (r *cardRepo) CreateCardOnly(ctx context.Context, in *Card) error {
+	r.cards[in.CardID] = in
+	return nil
+}
+
+type cardTx struct{}
+
+func (cardTx) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestCardProvider(t *testing.T) {
+	ispay := &fakeCardProvider{name: CardProviderISPay}
+	interlace := &fakeCardProvider{name: CardProviderInterlace}
+	repo := &cardRepo{
+		configs: map[string]string{"card_product_provider": "411111:ispay, 522222:interlace, 533333:nope"},
+		cards:   map[string]*Card{"c1": {CardID: "c1", Bin: "411111"}},
+	}
+	uuc := &UserUseCase{repo: repo, providers: CardProviders{CardProviderISPay: ispay, CardProviderInterlace: interlace}}
+
+	tests := []struct {
+		name      string
+		productId string
+		fallback  string
+		want      string
+	}{
+		{"按配置", "411111", CardProviderInterlace, CardProviderISPay},
+		{"配置和默认相同", "522222", CardProviderISPay, CardProviderInterlace},
+		{"没配置用默认", "999999", CardProviderInterlace, CardProviderInterlace},
+		{"渠道不存在用默认", "533333", CardProviderISPay, CardProviderISPay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uuc.cardProvider(tt.productId, tt.fallback).Name(); tt.want != got {
+				t.Fatalf("cardProvider(%s) = %s, want %s", tt.productId, got, tt.want)
+			}
+		})
+	}
+
+	// 已入库的卡按 BIN，没入库的用默认
+	if got := uuc.cardProviderByCard(context.Background(), "c1", CardProviderInterlace).Name(); CardProviderISPay != got {
+		t.Fatalf("cardProviderByCard(c1) = %s", got)
+	}
+	if got := uuc.cardProviderByCard(context.Background(), "c2", CardProviderInterlace).Name(); CardProviderInterlace != got {
+		t.Fatalf("cardProviderByCard(c2) = %s", got)
+	}
+}
+
+func TestPullAllCard(t *testing.T) {
+	ispay := &fakeCardProvider{name: CardProviderISPay}
+	interlace := &fakeCardProvider{name: CardProviderInterlace, pages: [][]*ProviderCard{{
+		{Card: &Card{CardID: "c1", Bin: "522222", Status: "ACTIVE", InterlaceCreateTime: 1}},
+		{Card: &Card{CardID: "c2", Bin: "522222", Status: "FROZEN", InterlaceCreateTime: 1}},
+	}}}
+	repo := &cardRepo{
+		configs: map[string]string{"card_reserve_product": "522222", "card_product_provider": "411111:ispay"},
+		cards:   map[string]*Card{},
+	}
+	uuc := &UserUseCase{repo: repo, tx: cardTx{}, providers: CardProviders{CardProviderISPay: ispay, CardProviderInterlace: interlace}}
+
+	if _, err := uuc.PullAllCard(context.Background(), nil); nil != err {
+		t.Fatal(err)
+	}
+
+	// 只入库 ACTIVE 的，拉到空页结束
+	if _, ok := repo.cards["c1"]; !ok || 1 != len(repo.cards) || 2 != interlace.calls || 0 != ispay.calls {
+		t.Fatalf("cards = %v, calls = %d/%d", repo.cards, interlace.calls, ispay.calls)
+	}
+
+	// 储备卡配置到不支持列表的渠道，第一页就结束
+	repo.configs["card_reserve_product"] = "411111"
+	if _, err := uuc.PullAllCard(context.Background(), nil); nil != err {
+		t.Fatal(err)
+	}
+	if 1 != ispay.calls {
+		t.Fatalf("ispay calls = %d", ispay.calls)
+	}
+}
+
+func TestISPayIssueCardAmount(t *testing.T) {
+	var amounts []uint64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			CardAmount uint64 `json:"cardAmount"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		amounts = append(amounts, body.CardAmount)
+		_, _ = w.Write([]byte(`{"code":200,"data":{"cardId":"c1","cardOrderId":"o1","cardStatus":"PENDING"}}`))
+	}))
+	defer server.Close()
+
+	p := NewISPayProvider(&ISPayConfig{BaseURL: server.URL})
+
+	// 渠道只收整数，有小数的在请求前拒绝，不能截断后开卡
+	for _, v := range []string{"10.5", "0.01", "-10"} {
+		_, err := p.IssueCard(context.Background(), &IssueCardReq{CardholderId: "1", ProductId: "2", Amount: money.MustParse(v)})
+		if nil == err {
+			t.Fatalf("IssueCard(%s) err = nil", v)
+		}
+	}
+	if 0 != len(amounts) {
+		t.Fatalf("amounts = %v", amounts)
+	}
+
+	res, err := p.IssueCard(context.Background(), &IssueCardReq{CardholderId: "1", ProductId: "2", Amount: money.MustParse("10")})
+	if nil != err || "c1" != res.Card.CardID || 1 != len(amounts) || 10 != amounts[0] {
+		t.Fatalf("IssueCard(10) = %v, %v, amounts = %v", res, err, amounts)
+	}
+}
@@ -0,0 +1,217 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 平台账户，和用户账户对记，合计始终为0
+const (
+	AccountOpening  = "platform:opening"  // 上线记账前的期初余额
+	AccountDeposit  = "platform:deposit"  // 链上充值入金
+	AccountWithdraw = "platform:withdraw" // 提现待付
+	AccountFee      = "platform:fee"      // 手续费收入
+	AccountCard     = "platform:card"     // 开卡费
+	AccountReward   = "platform:reward"   // 推荐奖励支出
+)
+
+// Posting 一条分录，正数增加账户余额，负数减少
+type Posting struct {
+	Account string
+	Amount  money.Money
+}
+
+// Journal 一笔业务的全部分录，Reason 沿用 reward 的理由
+type Journal struct {
+	ID        uint64
+	Reason    pb.RewardReason
+	Ref       string
+	Remark    string
+	Postings  []*Posting
+	CreatedAt time.Time
+}
+
+// LedgerRebuild 用户余额按分录重算的结果
+type LedgerRebuild struct {
+	UserId uint64
+	Before money.Money
+	After  money.Money
+}
+
+// StatementLine 对账单一行，ID 为分录 id，Balance 为这一笔之后的余额
+type StatementLine struct {
+	ID        uint64
+	JournalId uint64
+	Reason    pb.RewardReason
+	Ref       string
+	Remark    string
+	Amount    money.Money
+	Balance   money.Money
+	CreatedAt time.Time
+}
+
+// UserAccount 用户账户编号
+func UserAccount(userId uint64) string {
+	return "user:" + strconv.FormatUint(userId, 10)
+}
+
+// AccountUserId 用户账户编号里的用户 id，平台账户返回0
+func AccountUserId(account string) uint64 {
+	if !strings.HasPrefix(account, "user:") {
+		return 0
+	}
+
+	res, _ := strconv.ParseUint(strings.TrimPrefix(account, "user:"), 10, 64)
+	return res
+}
+
+func NewJournal(reason pb.RewardReason, ref string) *Journal {
+	return &Journal{
+		Reason:   reason,
+		Ref:      ref,
+		Postings: make([]*Posting, 0),
+	}
+}
+
+// Move 从 from 转 amount 到 to，两条分录
+func (j *Journal) Move(from, to string, amount money.Money) *Journal {
+	j.Postings = append(j.Postings, &Posting{Account: from, Amount: amount.Neg()}, &Posting{Account: to, Amount: amount})
+	return j
+}
+
+// LedgerPost 记账，所有用户余额变动都走这里，分录合计不为0的拒绝，需在事务内调用。
+// 用户余额不足时失败，user.amount 只是账户余额的缓存
+func (uuc *UserUseCase) LedgerPost(ctx context.Context, j *Journal) error {
+	if 0 >= len(j.Postings) {
+		return errors.New(500, "LEDGER_ERROR", "没有分录")
+	}
+
+	sum := money.Zero()
+	for _, v := range j.Postings {
+		sum = sum.Add(v.Amount)
+	}
+
+	if 0 != sum.Sign() {
+		return errors.New(500, "LEDGER_UNBALANCED", "分录不平")
+	}
+
+	return uuc.repo.PostJournal(ctx, j)
+}
+
+// LedgerRebuildAll 全部用户开户并按分录重算余额缓存，不一致的打印出来
+func (uuc *UserUseCase) LedgerRebuildAll(ctx context.Context) ([]*LedgerRebuild, error) {
+	var (
+		users []*User
+		err   error
+	)
+
+	users, err = uuc.repo.GetAllUsers()
+	if nil != err {
+		return nil, err
+	}
+
+	res := make([]*LedgerRebuild, 0)
+	for _, v := range users {
+		if nil != ctx.Err() {
+			return res, ctx.Err()
+		}
+
+		var tmp *LedgerRebuild
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			tmp, err = uuc.repo.LedgerRebuildUser(ctx, v.ID)
+			return err
+		}); nil != err {
+			fmt.Println("余额重算失败", v.ID, err)
+			continue
+		}
+
+		if nil != tmp {
+			fmt.Println("余额和分录不一致，已按分录修正", tmp.UserId, tmp.Before, tmp.After)
+			res = append(res, tmp)
+		}
+	}
+
+	return res, nil
+}
+
+// statementBatch 对账单每批查询条数
+const statementBatch = 500
+
+// UserStatement 用户对账单，先回调期初余额，[start, end) 内的分录再按记账顺序分批回调，每行带余额，返回期初和期末余额
+func (uuc *UserUseCase) UserStatement(ctx context.Context, userId uint64, start, end time.Time, open func(money.Money) error, fn func([]*StatementLine) error) (money.Money, money.Money, error) {
+	opening, err := uuc.repo.GetLedgerBalanceBefore(ctx, userId, start)
+	if nil != err {
+		return money.Zero(), money.Zero(), err
+	}
+
+	if nil != open {
+		if err = open(opening); nil != err {
+			return opening, opening, err
+		}
+	}
+
+	balance := opening
+	afterId := uint64(0)
+	for {
+		if nil != ctx.Err() {
+			return opening, balance, ctx.Err()
+		}
+
+		var lines []*StatementLine
+		lines, err = uuc.repo.GetLedgerStatement(ctx, userId, start, end, afterId, statementBatch)
+		if nil != err {
+			return opening, balance, err
+		}
+
+		if 0 >= len(lines) {
+			break
+		}
+
+		for _, v := range lines {
+			balance = balance.Add(v.Amount)
+			v.Balance = balance
+			afterId = v.ID
+		}
+
+		if err = fn(lines); nil != err {
+			return opening, balance, err
+		}
+
+		if statementBatch > len(lines) {
+			break
+		}
+	}
+
+	return opening, balance, nil
+}
+
+// ParseStatementRange 对账单日期，按北京时间的自然日，含 end 当天，end 为空到现在
+func ParseStatementRange(start, end string) (time.Time, time.Time, error) {
+	loc := time.FixedZone("CST", 8*3600)
+
+	startTime, err := time.ParseInLocation("2006-01-02", start, loc)
+	if nil != err {
+		return time.Time{}, time.Time{}, errors.New(500, "DATE_ERROR", "开始日期格式错误")
+	}
+
+	endTime := time.Now()
+	if "" != end {
+		endTime, err = time.ParseInLocation("2006-01-02", end, loc)
+		if nil != err {
+			return time.Time{}, time.Time{}, errors.New(500, "DATE_ERROR", "结束日期格式错误")
+		}
+		endTime = endTime.AddDate(0, 0, 1)
+	}
+
+	if !startTime.Before(endTime) {
+		return time.Time{}, time.Time{}, errors.New(500, "DATE_ERROR", "日期范围错误")
+	}
+
+	return startTime, endTime, nil
+}
@@ -0,0 +1,204 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// rewardReasonSign reward 记录对余额的方向，1 加 -1 减。
+// 提现和划转以 withdraw、transfer 表为准，这里不算
+var rewardReasonSign = map[pb.RewardReason]int64{
+	pb.RewardReason_REWARD_REASON_DEPOSIT:                1,
+	pb.RewardReason_REWARD_REASON_OPEN_CARD:              -1,
+	pb.RewardReason_REWARD_REASON_CARD_RECHARGE:          -1,
+	pb.RewardReason_REWARD_REASON_CARD_RECOMMEND:         1,
+	pb.RewardReason_REWARD_REASON_CARD_REFUND:            1,
+	pb.RewardReason_REWARD_REASON_OPEN_CARD_TWO:          -1,
+	pb.RewardReason_REWARD_REASON_CARD_TWO_RECOMMEND:     1,
+	pb.RewardReason_REWARD_REASON_WITHDRAW_REJECT_REFUND: 1,
+	pb.RewardReason_REWARD_REASON_WITHDRAW_DUST_REFUND:   1,
+	pb.RewardReason_REWARD_REASON_WITHDRAW_FAILED_REFUND: 1,
+}
+
+// BalanceHistory 用户记账开户之后的记录合计，Opening 为开户时的期初余额，未开户的从头算。
+// Stored 为同一次读取时的 user.amount
+type BalanceHistory struct {
+	Stored      money.Money
+	Opening     money.Money
+	Rewards     map[pb.RewardReason]money.Money
+	Withdraw    money.Money
+	TransferOut money.Money // 含手续费
+	TransferIn  money.Money
+}
+
+// Expected 按历史记录应有的余额
+func (h *BalanceHistory) Expected() money.Money {
+	res := h.Opening
+	for reason, amount := range h.Rewards {
+		if sign, ok := rewardReasonSign[reason]; ok {
+			res = res.Add(amount.MulInt(sign))
+		}
+	}
+
+	return res.Sub(h.Withdraw).Sub(h.TransferOut).Add(h.TransferIn)
+}
+
+// String 对账明细，写在差异记录里方便排查
+func (h *BalanceHistory) String() string {
+	res := fmt.Sprintf("opening=%s withdraw=%s transfer_out=%s transfer_in=%s", h.Opening, h.Withdraw, h.TransferOut, h.TransferIn)
+
+	reasons := make([]int, 0, len(h.Rewards))
+	for reason := range h.Rewards {
+		if _, ok := rewardReasonSign[reason]; ok {
+			reasons = append(reasons, int(reason))
+		}
+	}
+	sort.Ints(reasons)
+
+	for _, v := range reasons {
+		res += fmt.Sprintf(" reason_%d=%s", v, h.Rewards[pb.RewardReason(v)])
+	}
+
+	return res
+}
+
+// BalanceDrift 对账差异，Drift = Stored - Expected
+type BalanceDrift struct {
+	ID        uint64
+	RunId     string
+	UserId    uint64
+	Stored    money.Money
+	Expected  money.Money
+	Drift     money.Money
+	Detail    string
+	CreatedAt time.Time
+}
+
+// ReconcileBalances 全部用户按 reward、withdraw、transfer 记录重算余额，和 user.amount 不一致的写差异记录，只记录不修正
+func (uuc *UserUseCase) ReconcileBalances(ctx context.Context) (string, []*BalanceDrift, error) {
+	var (
+		users []*User
+		err   error
+	)
+
+	runId := time.Now().Format("20060102150405")
+	users, err = uuc.repo.GetAllUsers()
+	if nil != err {
+		return runId, nil, err
+	}
+
+	res := make([]*BalanceDrift, 0)
+	for _, v := range users {
+		if nil != ctx.Err() {
+			return runId, res, ctx.Err()
+		}
+
+		// 余额和历史记录要一起读，用 GetAllUsers 时的余额会和之后的记账对不上
+		var history *BalanceHistory
+		history, err = uuc.repo.GetUserBalanceHistory(ctx, v.ID)
+		if nil != err {
+			fmt.Println("对账失败", v.ID, err)
+			continue
+		}
+
+		if nil == history {
+			continue
+		}
+
+		expected := history.Expected()
+		if 0 == expected.Cmp(history.Stored) {
+			continue
+		}
+
+		drift := &BalanceDrift{
+			RunId:    runId,
+			UserId:   v.ID,
+			Stored:   history.Stored,
+			Expected: expected,
+			Drift:    history.Stored.Sub(expected),
+			Detail:   history.String(),
+		}
+		if err = uuc.repo.CreateBalanceDrift(ctx, drift); nil != err {
+			fmt.Println("对账差异记录失败", v.ID, err)
+			continue
+		}
+
+		res = append(res, drift)
+	}
+
+	return runId, res, nil
+}
+
+// AdminBalanceDriftList 对账差异，不传批次查最近一次
+func (uuc *UserUseCase) AdminBalanceDriftList(ctx context.Context, req *pb.AdminBalanceDriftListRequest) (*pb.AdminBalanceDriftListReply, error) {
+	var (
+		userSearch *User
+		userId     uint64
+		drifts     []*BalanceDrift
+		userIds    []uint64
+		users      map[uint64]*User
+		count      int64
+		err        error
+	)
+	res := &pb.AdminBalanceDriftListReply{
+		List: make([]*pb.AdminBalanceDriftListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
+		}
+
+		userId = userSearch.ID
+	}
+
+	runId := req.RunId
+	if "" == runId {
+		runId, err = uuc.repo.GetLastBalanceDriftRunId()
+		if nil != err || "" == runId {
+			return res, nil
+		}
+	}
+	res.RunId = runId
+
+	drifts, err, count = uuc.repo.GetBalanceDriftPage(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, runId, userId)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = uint64(count)
+
+	for _, v := range drifts {
+		userIds = append(userIds, v.UserId)
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(userIds...)
+	for _, v := range drifts {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
+
+		res.List = append(res.List, &pb.AdminBalanceDriftListReply_List{
+			Id:        v.ID,
+			Address:   tmpUser,
+			Stored:    v.Stored.String(),
+			Expected:  v.Expected.String(),
+			Drift:     v.Drift.String(),
+			Detail:    v.Detail,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,59 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"testing"
+)
+
+// reconcileRepo GetAllUsers 返回的余额是旧的，历史记录和余额在之后一起读
+type reconcileRepo struct {
+	UserRepo
+
+	users     []*User
+	histories map[uint64]*BalanceHistory
+	drifts    []*BalanceDrift
+}
+
+func (r *reconcileRepo) GetAllUsers() ([]*User, error) {
+	return r.users, nil
+}
+
+func (r *reconcileRepo) GetUserBalanceHistory(ctx context.Context, userId uint64) (*BalanceHistory, error) {
+	return r.histories[userId], nil
+}
+
+func (r *reconcileRepo) CreateBalanceDrift(ctx context.Context, d *BalanceDrift) error {
+	r.drifts = append(r.drifts, d)
+	return nil
+}
+
+func TestReconcileBalancesUsesSnapshot(t *testing.T) {
+	repo := &reconcileRepo{
+		users: []*User{
+			{ID: 1, Amount: money.MustParse("10")},
+			{ID: 2, Amount: money.MustParse("10")},
+			{ID: 3, Amount: money.MustParse("10")},
+		},
+		histories: map[uint64]*BalanceHistory{
+			// 读用户列表之后又充值了 5，快照里余额和记录一致
+			1: {Stored: money.MustParse("15"), Rewards: map[pb.RewardReason]money.Money{pb.RewardReason_REWARD_REASON_DEPOSIT: money.MustParse("15")}},
+			2: {Stored: money.MustParse("12"), Rewards: map[pb.RewardReason]money.Money{pb.RewardReason_REWARD_REASON_DEPOSIT: money.MustParse("10")}},
+		},
+	}
+	uuc := &UserUseCase{repo: repo}
+
+	_, drifts, err := uuc.ReconcileBalances(context.Background())
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	// 用户 3 已删除，跳过
+	if 1 != len(drifts) || 2 != drifts[0].UserId {
+		t.Fatalf("drifts = %+v", drifts)
+	}
+	if "12" != drifts[0].Stored.String() || "10" != drifts[0].Expected.String() || "2" != drifts[0].Drift.String() {
+		t.Fatalf("drift = %+v", drifts[0])
+	}
+}
@@ -0,0 +1,289 @@
+package biz
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strconv"
+	"time"
+)
+
+// Transfer 用户之间划转，IdemKey 同一用户内唯一，重复提交返回第一次的结果
+type Transfer struct {
+	ID        uint64
+	UserId    uint64
+	ToUserId  uint64
+	Amount    money.Money
+	Fee       money.Money
+	IdemKey   string
+	CreatedAt time.Time
+}
+
+// TransferConfig 划转配置，DailyAmount、DailyCount 为0不限制
+type TransferConfig struct {
+	Min         money.Money
+	DailyAmount money.Money
+	DailyCount  int64
+	FeeFlat     money.Money
+	FeeRate     money.Money
+}
+
+// Fee 手续费，保留两位小数，由转出方另外支付
+func (c *TransferConfig) Fee(amount money.Money) money.Money {
+	return money.MustParse(c.FeeFlat.Add(amount.Mul(c.FeeRate)).StringFixed(2))
+}
+
+// GetTransferConfig 配置表 transfer_*
+func (uuc *UserUseCase) GetTransferConfig() *TransferConfig {
+	var (
+		configs []*Config
+	)
+
+	res := &TransferConfig{}
+	configs, _ = uuc.repo.GetConfigByKeys("transfer_min", "transfer_daily_amount", "transfer_daily_count", "transfer_fee_flat", "transfer_fee_rate")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "transfer_min" == vConfig.KeyName {
+				res.Min, _ = money.Parse(vConfig.Value)
+			}
+			if "transfer_daily_amount" == vConfig.KeyName {
+				res.DailyAmount, _ = money.Parse(vConfig.Value)
+			}
+			if "transfer_daily_count" == vConfig.KeyName {
+				res.DailyCount, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+			if "transfer_fee_flat" == vConfig.KeyName {
+				res.FeeFlat, _ = money.Parse(vConfig.Value)
+			}
+			if "transfer_fee_rate" == vConfig.KeyName {
+				res.FeeRate, _ = money.Parse(vConfig.Value)
+			}
+		}
+	}
+
+	return res
+}
+
+// Transfer 划转给另一个用户，转出方扣 amount+手续费，转入方加 amount
+func (uuc *UserUseCase) Transfer(ctx context.Context, userId uint64, toAddress string, amount money.Money, idemKey string) (*Transfer, error) {
+	var (
+		user      *User
+		toUser    *User
+		transfer  *Transfer
+		dayAmount money.Money
+		dayCount  int64
+		err       error
+	)
+
+	if "" == idemKey || 100 < len(idemKey) {
+		return nil, errors.New(500, "TRANSFER_ERROR", "缺少请求编号")
+	}
+
+	if 0 >= amount.Sign() {
+		return nil, errors.New(500, "TRANSFER_ERROR", "划转金额错误")
+	}
+
+	user, err = uuc.repo.GetUserById(userId)
+	if nil != err {
+		return nil, err
+	}
+	if transferLocked(user) {
+		return nil, errors.New(500, "TRANSFER_ERROR", "用户不存在或已锁定")
+	}
+
+	toUser, err = uuc.repo.GetUserByAddress(toAddress)
+	if nil != err {
+		return nil, err
+	}
+	if transferLocked(toUser) {
+		return nil, errors.New(500, "TRANSFER_ERROR", "收款用户不存在或已锁定")
+	}
+
+	if user.ID == toUser.ID {
+		return nil, errors.New(500, "TRANSFER_ERROR", "不能划转给自己")
+	}
+
+	// 重复提交
+	transfer, err = uuc.transferReplay(userId, idemKey, toUser.ID, amount)
+	if nil != err || nil != transfer {
+		return transfer, err
+	}
+
+	config := uuc.GetTransferConfig()
+	if 0 < config.Min.Cmp(amount) {
+		return nil, errors.New(500, "TRANSFER_ERROR", "划转金额低于最低限额")
+	}
+
+	fee := config.Fee(amount)
+	transfer = &Transfer{
+		UserId:   userId,
+		ToUserId: toUser.ID,
+		Amount:   amount,
+		Fee:      fee,
+		IdemKey:  idemKey,
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 锁住转出用户后再算当天额度，并发的划转不会都按旧的合计通过
+		user, err = uuc.repo.LockTransferUser(ctx, userId)
+		if nil != err {
+			return err
+		}
+		if transferLocked(user) {
+			return errors.New(500, "TRANSFER_ERROR", "用户不存在或已锁定")
+		}
+
+		// 当天已划转，按北京时间自然日
+		loc := time.FixedZone("CST", 8*3600)
+		now := time.Now().In(loc)
+		dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+		dayAmount, dayCount, err = uuc.repo.GetTransferDayTotal(ctx, userId, dayStart)
+		if nil != err {
+			return err
+		}
+
+		if 0 < config.DailyCount && dayCount >= config.DailyCount {
+			return errors.New(500, "TRANSFER_LIMIT", "今日划转次数已达上限")
+		}
+
+		if 0 < config.DailyAmount.Sign() && 0 < dayAmount.Add(amount).Cmp(config.DailyAmount) {
+			return errors.New(500, "TRANSFER_LIMIT", "今日划转金额已达上限")
+		}
+
+		err = uuc.repo.CreateTransfer(ctx, transfer)
+		if nil != err {
+			return err
+		}
+
+		j := NewJournal(pb.RewardReason_REWARD_REASON_TRANSFER, "transfer:"+strconv.FormatUint(transfer.ID, 10))
+		j.Postings = append(j.Postings,
+			&Posting{Account: UserAccount(userId), Amount: amount.Add(fee).Neg()},
+			&Posting{Account: UserAccount(toUser.ID), Amount: amount},
+		)
+		if 0 < fee.Sign() {
+			j.Postings = append(j.Postings, &Posting{Account: AccountFee, Amount: fee})
+		}
+
+		err = uuc.LedgerPost(ctx, j)
+		if nil != err {
+			return err
+		}
+
+		return uuc.repo.AmountTo(ctx, userId, toUser.ID, toAddress, amount)
+	}); nil != err {
+		// 并发的重复提交，另一个已经成功
+		if errors.Reason(err) == "TRANSFER_DUPLICATE" {
+			return uuc.transferReplay(userId, idemKey, toUser.ID, amount)
+		}
+
+		fmt.Println("划转失败", userId, toAddress, amount, err)
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// transferLocked 用户不存在、已删除或被禁止划转
+func transferLocked(user *User) bool {
+	return nil == user || 0 != user.IsDelete || 0 != user.LockTransfer
+}
+
+// SetLockTransfer 设置用户禁止划转
+func (uuc *UserUseCase) SetLockTransfer(ctx context.Context, req *pb.SetLockTransferRequest) (*pb.SetLockTransferReply, error) {
+	if nil == req.SendBody {
+		return nil, errors.New(500, "TRANSFER_ERROR", "参数错误")
+	}
+
+	var lock uint64
+	if 1 == req.SendBody.LockTransfer {
+		lock = 1
+	}
+
+	err := uuc.repo.SetLockTransfer(ctx, req.SendBody.UserId, lock)
+	if nil != err {
+		return nil, err
+	}
+
+	return &pb.SetLockTransferReply{}, nil
+}
+
+// transferReplay 请求编号已用过时返回第一次的结果，收款人或金额不同的拒绝
+func (uuc *UserUseCase) transferReplay(userId uint64, idemKey string, toUserId uint64, amount money.Money) (*Transfer, error) {
+	transfer, err := uuc.repo.GetTransferByIdemKey(userId, idemKey)
+	if nil != err || nil == transfer {
+		return nil, err
+	}
+
+	if transfer.ToUserId != toUserId || 0 != transfer.Amount.Cmp(amount) {
+		return nil, errors.New(500, "TRANSFER_IDEM_CONFLICT", "请求编号已用于其他划转")
+	}
+
+	return transfer, nil
+}
+
+// AdminTransferList 划转记录
+func (uuc *UserUseCase) AdminTransferList(ctx context.Context, req *pb.AdminTransferListRequest) (*pb.AdminTransferListReply, error) {
+	var (
+		userSearch *User
+		userId     uint64
+		transfers  []*Transfer
+		userIds    []uint64
+		users      map[uint64]*User
+		count      int64
+		err        error
+	)
+	res := &pb.AdminTransferListReply{
+		List: make([]*pb.AdminTransferListReply_List, 0),
+	}
+
+	// 地址查询，转出和转入都算
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
+		}
+
+		userId = userSearch.ID
+	}
+
+	transfers, err, count = uuc.repo.GetTransferPage(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = uint64(count)
+
+	for _, v := range transfers {
+		userIds = append(userIds, v.UserId, v.ToUserId)
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(userIds...)
+	for _, v := range transfers {
+		tmpUser := ""
+		tmpToUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+			if _, ok := users[v.ToUserId]; ok {
+				tmpToUser = users[v.ToUserId].Address
+			}
+		}
+
+		res.List = append(res.List, &pb.AdminTransferListReply_List{
+			Id:        v.ID,
+			Address:   tmpUser,
+			ToAddress: tmpToUser,
+			Amount:    v.Amount.String(),
+			Fee:       v.Fee.String(),
+			IdemKey:   v.IdemKey,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
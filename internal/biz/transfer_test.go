@@ -0,0 +1,202 @@
+package biz
+
+import (
+	"cardbinance/internal/pkg/money"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// transferRepo 按数据库的行为模拟划转：LockTransferUser 锁住用户行到事务结束，
+// 事务内新建的划转提交后其他事务才看得到
+type transferRepo struct {
+	UserRepo
+
+	mu        sync.Mutex
+	users     map[uint64]*User
+	rows      map[uint64]*sync.Mutex
+	configs   []*Config
+	transfers []*Transfer
+}
+
+type transferTxKey struct{}
+
+// transferTx 事务持有的行锁和未提交的划转
+type transferTx struct {
+	locks     []*sync.Mutex
+	transfers []*Transfer
+}
+
+func newTransferRepo(users ...*User) *transferRepo {
+	r := &transferRepo{
+		users: make(map[uint64]*User),
+		rows:  make(map[uint64]*sync.Mutex),
+	}
+
+	for _, v := range users {
+		r.users[v.ID] = v
+		r.rows[v.ID] = &sync.Mutex{}
+	}
+
+	return r
+}
+
+func (r *transferRepo) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx := &transferTx{}
+	err := fn(context.WithValue(ctx, transferTxKey{}, tx))
+	if nil == err {
+		r.mu.Lock()
+		r.transfers = append(r.transfers, tx.transfers...)
+		r.mu.Unlock()
+	}
+
+	for _, v := range tx.locks {
+		v.Unlock()
+	}
+
+	return err
+}
+
+func (r *transferRepo) GetConfigByKeys(keys ...string) ([]*Config, error) {
+	return r.configs, nil
+}
+
+func (r *transferRepo) GetUserById(userId uint64) (*User, error) {
+	return r.users[userId], nil
+}
+
+func (r *transferRepo) GetUserByAddress(address string) (*User, error) {
+	for _, v := range r.users {
+		if address == v.Address {
+			return v, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *transferRepo) GetTransferByIdemKey(userId uint64, idemKey string) (*Transfer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, v := range r.transfers {
+		if userId == v.UserId && idemKey == v.IdemKey {
+			return v, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *transferRepo) LockTransferUser(ctx context.Context, userId uint64) (*User, error) {
+	row, ok := r.rows[userId]
+	if !ok {
+		return nil, nil
+	}
+
+	row.Lock()
+	tx := ctx.Value(transferTxKey{}).(*transferTx)
+	tx.locks = append(tx.locks, row)
+	return r.users[userId], nil
+}
+
+// GetTransferDayTotal 只读已提交的，读完停一下，没有锁时并发的事务都会读到旧的合计
+func (r *transferRepo) GetTransferDayTotal(ctx context.Context, userId uint64, start time.Time) (money.Money, int64, error) {
+	r.mu.Lock()
+	total, count := money.Zero(), int64(0)
+	for _, v := range r.transfers {
+		if userId == v.UserId {
+			total = total.Add(v.Amount)
+			count++
+		}
+	}
+	r.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+	return total, count, nil
+}
+
+func (r *transferRepo) CreateTransfer(ctx context.Context, t *Transfer) error {
+	r.mu.Lock()
+	t.ID = uint64(len(r.transfers) + 1)
+	r.mu.Unlock()
+
+	tx := ctx.Value(transferTxKey{}).(*transferTx)
+	tx.transfers = append(tx.transfers, t)
+	return nil
+}
+
+func (r *transferRepo) PostJournal(ctx context.Context, j *Journal) error {
+	return nil
+}
+
+func (r *transferRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount money.Money) error {
+	return nil
+}
+
+func TestTransferDailyLimitConcurrent(t *testing.T) {
+	repo := newTransferRepo(&User{ID: 1, Address: "alice"}, &User{ID: 2, Address: "bob"})
+	repo.configs = []*Config{{KeyName: "transfer_daily_amount", Value: "25"}}
+	uuc := &UserUseCase{repo: repo, tx: repo}
+
+	const n = 8
+	var (
+		wg    sync.WaitGroup
+		start = make(chan struct{})
+		errs  = make([]error, n)
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			_, errs[i] = uuc.Transfer(context.Background(), 1, "bob", money.MustParse("10"), fmt.Sprint("key-", i))
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	// 当天额度 25 只够两笔，其余在锁住用户后按新的合计拒绝
+	ok, limited := 0, 0
+	for _, err := range errs {
+		switch {
+		case nil == err:
+			ok++
+		case "TRANSFER_LIMIT" == errors.Reason(err):
+			limited++
+		default:
+			t.Fatalf("Transfer() err = %v", err)
+		}
+	}
+	if 2 != ok || n-2 != limited || 2 != len(repo.transfers) {
+		t.Fatalf("ok = %d, limited = %d, transfers = %d", ok, limited, len(repo.transfers))
+	}
+}
+
+func TestTransferLocked(t *testing.T) {
+	tests := []struct {
+		name string
+		from *User
+		to   *User
+	}{
+		{"转出用户已删除", &User{ID: 1, Address: "alice", IsDelete: 1}, &User{ID: 2, Address: "bob"}},
+		{"转出用户禁止划转", &User{ID: 1, Address: "alice", LockTransfer: 1}, &User{ID: 2, Address: "bob"}},
+		{"收款用户已删除", &User{ID: 1, Address: "alice"}, &User{ID: 2, Address: "bob", IsDelete: 1}},
+		{"收款用户禁止划转", &User{ID: 1, Address: "alice"}, &User{ID: 2, Address: "bob", LockTransfer: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newTransferRepo(tt.from, tt.to)
+			uuc := &UserUseCase{repo: repo, tx: repo}
+
+			_, err := uuc.Transfer(context.Background(), 1, "bob", money.MustParse("10"), "key")
+			if "TRANSFER_ERROR" != errors.Reason(err) || 0 != len(repo.transfers) {
+				t.Fatalf("Transfer() err = %v, transfers = %d", err, len(repo.transfers))
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package biz
 import (
 	"bytes"
 	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/pkg/gas"
 	"cardbinance/internal/pkg/middleware/auth"
+	"cardbinance/internal/pkg/money"
 	"context"
 	"crypto/md5"
 	"crypto/tls"
@@ -14,12 +16,14 @@ import (
 	imap "github.com/emersion/go-imap"
 	"github.com/emersion/go-imap/client"
 	"github.com/emersion/go-message/mail"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	transporthttp "github.com/go-kratos/kratos/v2/transport/http"
 	jwt2 "github.com/golang-jwt/jwt/v5"
 	"html"
 	"io"
 	"io/ioutil"
+	"math/big"
 	"mime/multipart"
 	"net"
 	"net/http"
@@ -57,7 +61,7 @@ type CardTwo struct {
 	State            string
 	Status           uint64
 	CardId           string
-	CardAmount       float64
+	CardAmount       money.Money
 	IdCard           string
 	Gender           string
 	CreatedAt        time.Time
@@ -82,7 +86,7 @@ type CardTwoNew struct {
 	Status           uint64
 	Num              uint64
 	CardId           string
-	CardAmount       float64
+	CardAmount       money.Money
 	IdCard           string
 	Gender           string
 	CreatedAt        time.Time
@@ -105,10 +109,10 @@ type User struct {
 	Card             string
 	CardNumber       string
 	CardOrderId      string
-	CardAmount       float64
-	Amount           float64
+	CardAmount       money.Money
+	Amount           money.Money
 	AmountTwo        uint64
-	MyTotalAmount    float64
+	MyTotalAmount    money.Money
 	IsDelete         uint64
 	Vip              uint64
 	FirstName        string
@@ -139,6 +143,7 @@ type User struct {
 	CardTwoNumber    string
 	LockCard         uint64
 	LockCardTwo      uint64
+	LockTransfer     uint64 // 1 禁止划转
 	ChangeCard       uint64
 	ChangeCardTwo    uint64
 	Pic              string
@@ -162,15 +167,56 @@ type Config struct {
 	Value   string
 }
 
+// WithdrawPause 提现队列暂停状态，热钱包余额不足时自动暂停，余额回到恢复线以上自动恢复
+type WithdrawPause struct {
+	Paused    bool      `json:"paused"`
+	Reason    string    `json:"reason"`
+	Usdt      string    `json:"usdt"`
+	Bnb       string    `json:"bnb"`
+	PausedAt  time.Time `json:"pausedAt"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
 type Withdraw struct {
-	ID        uint64
-	UserId    uint64
-	Amount    float64
-	RelAmount float64
-	Status    string
-	Address   string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          uint64
+	UserId      uint64
+	Amount      money.Money
+	RelAmount   money.Money
+	Fee         money.Money
+	Status      string // review 待审核，pass 审核通过，rewarded 待处理，doing 处理中，broadcast 已广播，confirmed 链上确认（原 success），failed 链上失败已退款，rejected 已驳回，rejected_dust 金额过小未发放
+	Address     string
+	TxHash      string
+	TxHistory   string // 重发前用过的 hash，逗号分隔
+	Nonce       uint64
+	GasPrice    string
+	GasLimit    uint64
+	Rpc         string
+	Attempts    uint64
+	RawTx       string // 最近一次签名交易，重发时用
+	Remark      string
+	Dust        string // 金额过小的处理，refunded 已退回余额，carry 待并入下次提现，carried 已并入 CarryId
+	CarryId     uint64
+	GasUsed     uint64 // 上链后实际用量
+	GasCost     string // 上链后实际 gas 费 wei
+	BroadcastAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Hashes 当前和重发前的全部交易 hash，任何一笔上链都算这次提现
+func (w *Withdraw) Hashes() []string {
+	res := make([]string, 0)
+	if "" != w.TxHash {
+		res = append(res, w.TxHash)
+	}
+
+	for _, v := range strings.Split(w.TxHistory, ",") {
+		if "" != v {
+			res = append(res, v)
+		}
+	}
+
+	return res
 }
 
 type Card struct {
@@ -197,22 +243,242 @@ type Card struct {
 type Reward struct {
 	ID        uint64
 	UserId    uint64
-	Amount    float64
-	Reason    uint64
+	Amount    money.Money
+	Reason    pb.RewardReason
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Address   string
 	One       uint64
 }
 
+// rewardReasonLabels 原因文案，按语言区分，新增原因时这里一起补
+var rewardReasonLabels = map[string]map[pb.RewardReason]string{
+	"zh": {
+		pb.RewardReason_REWARD_REASON_DEPOSIT:                "充值",
+		pb.RewardReason_REWARD_REASON_WITHDRAW:               "提现",
+		pb.RewardReason_REWARD_REASON_OPEN_CARD:              "开虚拟卡",
+		pb.RewardReason_REWARD_REASON_CARD_RECHARGE:          "虚拟卡充值",
+		pb.RewardReason_REWARD_REASON_TRANSFER:               "划转",
+		pb.RewardReason_REWARD_REASON_CARD_RECOMMEND:         "虚拟卡开卡收益",
+		pb.RewardReason_REWARD_REASON_CARD_REFUND:            "开虚拟卡失败退款",
+		pb.RewardReason_REWARD_REASON_OPEN_CARD_TWO:          "开实体卡",
+		pb.RewardReason_REWARD_REASON_CARD_TWO_RECOMMEND:     "实体卡开卡收益",
+		pb.RewardReason_REWARD_REASON_WITHDRAW_REJECT_REFUND: "提现驳回退款",
+		pb.RewardReason_REWARD_REASON_WITHDRAW_DUST_REFUND:   "提现金额过小退款",
+		pb.RewardReason_REWARD_REASON_WITHDRAW_FAILED_REFUND: "提现链上失败退款",
+	},
+	"en": {
+		pb.RewardReason_REWARD_REASON_DEPOSIT:                "Deposit",
+		pb.RewardReason_REWARD_REASON_WITHDRAW:               "Withdrawal",
+		pb.RewardReason_REWARD_REASON_OPEN_CARD:              "Virtual card opening",
+		pb.RewardReason_REWARD_REASON_CARD_RECHARGE:          "Virtual card top-up",
+		pb.RewardReason_REWARD_REASON_TRANSFER:               "Transfer",
+		pb.RewardReason_REWARD_REASON_CARD_RECOMMEND:         "Virtual card referral reward",
+		pb.RewardReason_REWARD_REASON_CARD_REFUND:            "Virtual card opening refund",
+		pb.RewardReason_REWARD_REASON_OPEN_CARD_TWO:          "Physical card opening",
+		pb.RewardReason_REWARD_REASON_CARD_TWO_RECOMMEND:     "Physical card referral reward",
+		pb.RewardReason_REWARD_REASON_WITHDRAW_REJECT_REFUND: "Withdrawal rejected refund",
+		pb.RewardReason_REWARD_REASON_WITHDRAW_DUST_REFUND:   "Withdrawal too small refund",
+		pb.RewardReason_REWARD_REASON_WITHDRAW_FAILED_REFUND: "Withdrawal failed refund",
+	},
+}
+
+// RewardReasonLabel 原因文案，没有对应语言用中文，没有文案返回编号
+func RewardReasonLabel(reason pb.RewardReason, lang string) string {
+	labels, ok := rewardReasonLabels[lang]
+	if !ok {
+		labels = rewardReasonLabels["zh"]
+	}
+
+	if label, ok := labels[reason]; ok {
+		return label
+	}
+
+	return strconv.FormatInt(int64(reason), 10)
+}
+
 type EthUserRecord struct {
-	ID        int64
-	UserId    int64
-	Hash      string
-	Amount    string
-	AmountTwo uint64
-	Last      int64
-	CreatedAt time.Time
+	ID           int64
+	UserId       int64
+	Hash         string
+	Amount       string
+	AmountTwo    uint64
+	Last         int64
+	BlockNumber  uint64
+	ChainId      int64
+	Contract     string
+	DepositIndex int64  // 合约下标，-1为旧记录
+	Uncredited   uint64 // 1 记录时未加余额
+	Decimals     uint32 // Amount 的小数位，0为旧记录
+	CreatedAt    time.Time
+}
+
+// DepositOnChain 链上 users/usersAmount 中的一条
+type DepositOnChain struct {
+	Index   int64
+	Address string
+	Amount  *big.Int // 代币最小单位
+}
+
+// CreditAmount 入账金额，旧记录按 AmountTwo
+func (e *EthUserRecord) CreditAmount() money.Money {
+	if 0 == e.Decimals {
+		return money.New(int64(e.AmountTwo))
+	}
+
+	wei, ok := new(big.Int).SetString(e.Amount, 10)
+	if !ok {
+		return money.Zero()
+	}
+
+	// 超出 money.Scale 的小数位截断
+	decimals := e.Decimals
+	if money.Scale < decimals {
+		wei = ScaleAmount(wei, decimals, money.Scale)
+		decimals = money.Scale
+	}
+
+	res, _ := money.Parse(WeiToDecimal(wei, decimals))
+	return res
+}
+
+// wei 记录金额换算到 decimals 位最小单位，旧记录 AmountTwo 为整数个代币
+func (e *EthUserRecord) wei(decimals uint32) *big.Int {
+	if 0 == e.Decimals {
+		return ScaleAmount(new(big.Int).SetUint64(e.AmountTwo), 0, decimals)
+	}
+
+	wei, ok := new(big.Int).SetString(e.Amount, 10)
+	if !ok {
+		return new(big.Int)
+	}
+
+	return ScaleAmount(wei, e.Decimals, decimals)
+}
+
+// WeiToDecimal 最小单位转十进制字符串，不丢精度
+func WeiToDecimal(wei *big.Int, decimals uint32) string {
+	if nil == wei {
+		return "0"
+	}
+
+	neg := 0 > wei.Sign()
+	abs := new(big.Int).Abs(wei)
+	if 0 == decimals {
+		if neg {
+			return "-" + abs.String()
+		}
+		return abs.String()
+	}
+
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	integer, fraction := new(big.Int).QuoRem(abs, unit, new(big.Int))
+
+	res := integer.String()
+	if 0 != fraction.Sign() {
+		tmp := fraction.String()
+		tmp = strings.Repeat("0", int(decimals)-len(tmp)) + tmp
+		res += "." + strings.TrimRight(tmp, "0")
+	}
+
+	if neg {
+		return "-" + res
+	}
+
+	return res
+}
+
+// DecimalToWei 十进制字符串转最小单位，多出的小数位截断，格式错误返回0
+func DecimalToWei(value string, decimals uint32) *big.Int {
+	value = strings.TrimSpace(value)
+	tmp := strings.SplitN(value, ".", 2)
+	fraction := ""
+	if 2 == len(tmp) {
+		fraction = tmp[1]
+	}
+
+	if len(fraction) > int(decimals) {
+		fraction = fraction[:decimals]
+	}
+	fraction += strings.Repeat("0", int(decimals)-len(fraction))
+
+	res, ok := new(big.Int).SetString(tmp[0]+fraction, 10)
+	if !ok {
+		return new(big.Int)
+	}
+
+	return res
+}
+
+// ScaleAmount 金额从 from 位小数换算到 to 位小数，位数减少时截断
+func ScaleAmount(amount *big.Int, from, to uint32) *big.Int {
+	if nil == amount {
+		return new(big.Int)
+	}
+
+	if from == to {
+		return new(big.Int).Set(amount)
+	}
+
+	if from < to {
+		unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(to-from)), nil)
+		return new(big.Int).Mul(amount, unit)
+	}
+
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(from-to)), nil)
+	return new(big.Int).Quo(amount, unit)
+}
+
+type EthBlockCursor struct {
+	ID          uint64
+	ChainId     int64
+	Contract    string
+	BlockNumber uint64
+	BlockHash   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// EthDepositPending 低于最小充值金额的充值
+type EthDepositPending struct {
+	ID           int64
+	UserId       int64
+	Address      string
+	Hash         string
+	Amount       string // 代币最小单位
+	Decimals     uint32
+	BlockNumber  uint64
+	ChainId      int64
+	Contract     string
+	DepositIndex int64
+	Status       string // pending 累计中，review 待审核，credited 已入账，rejected 已驳回
+	Remark       string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// WithdrawFeeSchedule 提现手续费：固定手续费加比例，vip 级别有单独比例时用级别比例，到账金额不能低于最低到账
+type WithdrawFeeSchedule struct {
+	Flat     money.Money
+	Rate     money.Money
+	VipRates map[uint64]money.Money
+	MinNet   money.Money
+}
+
+// Fee 手续费和到账金额，手续费保留两位小数
+func (s *WithdrawFeeSchedule) Fee(amount money.Money, vip uint64) (money.Money, money.Money, error) {
+	rate := s.Rate
+	if tmp, ok := s.VipRates[vip]; ok {
+		rate = tmp
+	}
+
+	fee := money.MustParse(s.Flat.Add(amount.Mul(rate)).StringFixed(2))
+	net := amount.Sub(fee)
+	if 0 >= net.Sign() || 0 < s.MinNet.Cmp(net) {
+		return money.Zero(), money.Zero(), errors.New(500, "WITHDRAW_AMOUNT_ERROR", "扣除手续费后到账金额过低")
+	}
+
+	return fee, net, nil
 }
 
 type UserRepo interface {
@@ -231,21 +497,30 @@ type UserRepo interface {
 	GetUserRecommendLikeCode(code string) ([]*UserRecommend, error)
 	GetUserByUserIds(userIds ...uint64) (map[uint64]*User, error)
 	GetUserByUserIdsTwo(userIds []uint64) (map[uint64]*User, error)
-	CreateCard(ctx context.Context, userId uint64, user *User) error
 	HasCardByCardID(ctx context.Context, cardID string) (bool, error)
 	GetCardByCardId(ctx context.Context, cardId string) (*Card, error)
 	GetNoBindCardV(ctx context.Context) (*Card, error)
 	GetAllUsers() ([]*User, error)
 	UpdateCard(ctx context.Context, userId uint64, cardOrderId, card string) error
-	UpdateCardNo(ctx context.Context, userId uint64, amount float64) error
+	UpdateCardNo(ctx context.Context, userId uint64, amount money.Money) error
 	UpdateCardSucces(ctx context.Context, userId uint64, cardNum string) error
-	CreateCardRecommend(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error
-	CreateCardRecommendNew(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error
-	CreateCardRecommendTwo(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error
+	CreateCardRecommend(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error
+	CreateCardRecommendNew(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error
+	CreateCardRecommendTwo(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error
 	GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error)
-	AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount float64) error
-	Withdraw(ctx context.Context, userId uint64, amount, amountRel float64, address string) error
-	GetUserRewardByUserIdPage(ctx context.Context, b *Pagination, userId uint64, reason uint64) ([]*Reward, error, int64)
+	AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount money.Money) error
+	CreateTransfer(ctx context.Context, t *Transfer) error
+	GetTransferByIdemKey(userId uint64, idemKey string) (*Transfer, error)
+	LockTransferUser(ctx context.Context, userId uint64) (*User, error)
+	GetTransferDayTotal(ctx context.Context, userId uint64, start time.Time) (money.Money, int64, error)
+	GetTransferPage(b *Pagination, userId uint64) ([]*Transfer, error, int64)
+	GetUserBalanceHistory(ctx context.Context, userId uint64) (*BalanceHistory, error)
+	CreateBalanceDrift(ctx context.Context, d *BalanceDrift) error
+	GetLastBalanceDriftRunId() (string, error)
+	GetBalanceDriftPage(b *Pagination, runId string, userId uint64) ([]*BalanceDrift, error, int64)
+	Withdraw(ctx context.Context, userId uint64, amount, amountRel, fee money.Money, address string) (uint64, error)
+	GetFeeTotal(account string) (money.Money, error)
+	GetUserRewardByUserIdPage(ctx context.Context, b *Pagination, userId uint64, reason pb.RewardReason) ([]*Reward, error, int64)
 	SetVip(ctx context.Context, userId uint64, vip uint64) error
 	GetUsersOpenCard() ([]*User, error)
 	GetUsersOpenCardStatusDoing() ([]*User, error)
@@ -253,8 +528,44 @@ type UserRepo interface {
 	GetUserByAddresses(Addresses ...string) (map[string]*User, error)
 	GetUserRecommends() ([]*UserRecommend, error)
 	CreateEthUserRecordListByHash(ctx context.Context, r *EthUserRecord) (*EthUserRecord, error)
-	UpdateUserMyTotalAmountAdd(ctx context.Context, userId uint64, amount uint64) error
+	GetEthUserRecordByHash(hash string) (*EthUserRecord, error)
+	GetEthUserRecordByIndex(chainId int64, contract string, index int64) (*EthUserRecord, error)
+	GetEthUserRecords(chainId int64, contract string) ([]*EthUserRecord, error)
+	GetEthUserRecordsUncredited(limit int) ([]*EthUserRecord, error)
+	UpdateEthUserRecordCredited(ctx context.Context, id int64) error
+	DepositCredit(ctx context.Context, userId uint64, amount money.Money, amountTwo uint64, hash string) error
+	GetEthBlockCursor(chainId int64, contract string) (*EthBlockCursor, error)
+	SaveEthBlockCursor(ctx context.Context, cursor *EthBlockCursor) error
+	UpdateUserMyTotalAmountAdd(ctx context.Context, userId uint64, amount money.Money) error
+	CreateEthDepositPending(ctx context.Context, p *EthDepositPending) (*EthDepositPending, error)
+	GetEthDepositPendingById(id int64) (*EthDepositPending, error)
+	GetEthDepositPendingByIndex(chainId int64, contract string, index int64) (*EthDepositPending, error)
+	GetEthDepositPendingByUser(userId int64, chainId int64, contract string, status string) ([]*EthDepositPending, error)
+	GetEthDepositPendingPage(b *Pagination, userId int64, status string) ([]*EthDepositPending, error, int64)
+	UpdateEthDepositPendingStatus(ctx context.Context, id int64, from, to string, remark string) error
 	UpdateWithdraw(ctx context.Context, id uint64, status string) (*Withdraw, error)
+	UpdateWithdrawTx(ctx context.Context, w *Withdraw) error
+	UpdateWithdrawStatus(ctx context.Context, id uint64, from, to string) error
+	UpdateWithdrawReceipt(ctx context.Context, id uint64, to string, gasUsed uint64, gasCost string) error
+	GetWithdrawsByStatus(status string, limit int) ([]*Withdraw, error)
+	GetWithdrawById(id uint64) (*Withdraw, error)
+	GetWithdrawPage(b *Pagination, userId uint64, status string) ([]*Withdraw, error, int64)
+	UpdateWithdrawReview(ctx context.Context, id uint64, from, to string, remark string) error
+	WithdrawRefund(ctx context.Context, w *Withdraw, reason pb.RewardReason) error
+	PostJournal(ctx context.Context, j *Journal) error
+	LedgerRebuildUser(ctx context.Context, userId uint64) (*LedgerRebuild, error)
+	GetLedgerAccountBalance(ctx context.Context, account string) (money.Money, error)
+	GetLedgerBalanceBefore(ctx context.Context, userId uint64, before time.Time) (money.Money, error)
+	GetLedgerStatement(ctx context.Context, userId uint64, start, end time.Time, afterId uint64, limit int) ([]*StatementLine, error)
+	UpdateWithdrawDust(ctx context.Context, id uint64, dust string, remark string) error
+	GetWithdrawsByDust(dust string, limit int) ([]*Withdraw, error)
+	GetWithdrawsByCarryId(ctx context.Context, carryId uint64) ([]*Withdraw, error)
+	UpdateWithdrawDustRefund(ctx context.Context, id uint64, from string, remark string) error
+	AllocateNonce(ctx context.Context, address string, pending uint64) (uint64, error)
+	ReleaseNonce(ctx context.Context, address string, nonce uint64) error
+	GetWithdrawPause(ctx context.Context) (*WithdrawPause, error)
+	SetWithdrawPause(ctx context.Context, pause *WithdrawPause) error
+	ResetNonce(ctx context.Context, address string, next uint64, free []uint64) error
 	InsertCardRecord(ctx context.Context, userId, recordType uint64, remark string, code string, opt string) error
 	UpdateCardTwo(ctx context.Context, id uint64) error
 	GetUserCardTwo() ([]*Reward, error)
@@ -263,18 +574,19 @@ type UserRepo interface {
 	SetCanVip(ctx context.Context, userId uint64, lock uint64) (bool, error)
 	SetVipThree(ctx context.Context, userId uint64, vipThree uint64) (bool, error)
 	SetUserCount(ctx context.Context, userId uint64) (bool, error)
+	SetLockTransfer(ctx context.Context, userId uint64, lock uint64) error
 	GetConfigs() ([]*Config, error)
 	UpdateConfig(ctx context.Context, id int64, value string) (bool, error)
 	UpdateUserInfo(ctx context.Context, userId uint64, user *User) error
 	CreateCardOne(ctx context.Context, userId uint64, in *Card, isNew bool) error
-	UpdateUserDone(ctx context.Context, userId uint64, cardId string, cardAmount float64) error
+	UpdateUserDone(ctx context.Context, userId uint64, cardId string, cardAmount money.Money) error
 	CreateCardOnly(ctx context.Context, in *Card) error
 	CreateCardNew(ctx context.Context, userId, id uint64, in *Card, isNew bool) error
 	GetCardPage(ctx context.Context, b *Pagination, accountId, status string) ([]*Card, error, int64)
 	GetLatestCard(ctx context.Context) (*Card, error)
 	GetCardTwoStatusOne() ([]*CardTwo, error)
-	UpdateUserDoing(ctx context.Context, userId uint64, cardNumber, cardNumberRel string, cardAmount float64) error
-	UpdateCardStatus(ctx context.Context, id, userId uint64, cardNumber, cardNumberRel string, cardAmount float64) error
+	UpdateUserDoing(ctx context.Context, userId uint64, cardNumber, cardNumberRel string, cardAmount money.Money) error
+	UpdateCardStatus(ctx context.Context, id, userId uint64, cardNumber, cardNumberRel string, cardAmount money.Money) error
 	GetCardTwos(b *Pagination, userId uint64, status uint64, cardId string) ([]*CardTwo, error, int64)
 	GetCardTwosNew(b *Pagination, userId uint64, status uint64, cardId string) ([]*CardTwoNew, error, int64)
 	GetCardTwoById(id uint64) (*CardTwo, error)
@@ -284,16 +596,20 @@ type UserRepo interface {
 }
 
 type UserUseCase struct {
-	repo UserRepo
-	tx   Transaction
-	log  *log.Helper
+	repo      UserRepo
+	tx        Transaction
+	providers CardProviders
+	interlace *InterlaceProvider // 上传文件、查 BIN 等 Interlace 独有的接口
+	log       *log.Helper
 }
 
-func NewUserUseCase(repo UserRepo, tx Transaction, logger log.Logger) *UserUseCase {
+func NewUserUseCase(repo UserRepo, tx Transaction, providers CardProviders, interlace *InterlaceProvider, logger log.Logger) *UserUseCase {
 	return &UserUseCase{
-		repo: repo,
-		tx:   tx,
-		log:  log.NewHelper(logger),
+		repo:      repo,
+		tx:        tx,
+		providers: providers,
+		interlace: interlace,
+		log:       log.NewHelper(logger),
 	}
 }
 
@@ -311,727 +627,2128 @@ func (uuc *UserUseCase) GetUserByAddress(Addresses ...string) (map[string]*User,
 	return uuc.repo.GetUserByAddresses(Addresses...)
 }
 
-func (uuc *UserUseCase) DepositNew(ctx context.Context, userId uint64, amount uint64, eth *EthUserRecord, system bool) error {
-	// 推荐人
+func (uuc *UserUseCase) GetEthBlockCursor(chainId int64, contract string) (*EthBlockCursor, error) {
+	return uuc.repo.GetEthBlockCursor(chainId, contract)
+}
+
+func (uuc *UserUseCase) SaveEthBlockCursor(ctx context.Context, cursor *EthBlockCursor) error {
+	return uuc.repo.SaveEthBlockCursor(ctx, cursor)
+}
+
+// GetDepositScanConfig 区块扫描配置：确认数，起始区块，回滚区块数
+func (uuc *UserUseCase) GetDepositScanConfig() (uint64, uint64, uint64) {
 	var (
-		err error
+		configs       []*Config
+		confirmations uint64 = 15
+		startBlock    uint64
+		rewind        uint64 = 20
+	)
+
+	configs, _ = uuc.repo.GetConfigByKeys("deposit_confirmations", "deposit_start_block", "deposit_reorg_rewind")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "deposit_confirmations" == vConfig.KeyName {
+				confirmations, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+			}
+			if "deposit_start_block" == vConfig.KeyName {
+				startBlock, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+			}
+			if "deposit_reorg_rewind" == vConfig.KeyName {
+				rewind, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	return confirmations, startBlock, rewind
+}
+
+// DepositNew 充值，eth.Amount 为代币最小单位，eth.Decimals 为其小数位
+func (uuc *UserUseCase) DepositNew(ctx context.Context, userId uint64, eth *EthUserRecord, system bool) error {
+	return uuc.depositNew(ctx, userId, eth, system, nil)
+}
+
+// depositNew after 和入账在同一事务内执行，已入账过的单独执行 after，补上中断时没做完的部分
+func (uuc *UserUseCase) depositNew(ctx context.Context, userId uint64, eth *EthUserRecord, system bool, after func(ctx context.Context) error) error {
+	var (
+		record       *EthUserRecord
+		recommendIds []uint64
+		err          error
 	)
 
+	// 同一笔交易只入账一次，区块回滚重扫时会再次遇到
+	if "" != eth.Hash {
+		record, err = uuc.repo.GetEthUserRecordByHash(eth.Hash)
+		if nil != err {
+			return err
+		}
+
+		if nil != record {
+			return uuc.depositAfter(ctx, after)
+		}
+	}
+
+	// 链+合约+下标唯一
+	if 0 <= eth.DepositIndex && "" != eth.Contract {
+		record, err = uuc.repo.GetEthUserRecordByIndex(eth.ChainId, eth.Contract, eth.DepositIndex)
+		if nil != err {
+			return err
+		}
+
+		if nil != record {
+			return uuc.depositAfter(ctx, after)
+		}
+	}
+
+	creditOpen, teamOpen := uuc.getDepositPipelineConfig()
+	if creditOpen && teamOpen {
+		recommendIds, err = uuc.getRecommendUserIds(userId)
+		if nil != err {
+			return err
+		}
+	}
+
 	// 入金
 	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
 		// 充值记录
 		if !system {
+			var uncredited uint64 = 1
+			if creditOpen {
+				uncredited = 0
+			}
+
 			_, err = uuc.repo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
-				Hash:      eth.Hash,
-				UserId:    eth.UserId,
-				Amount:    eth.Amount,
-				AmountTwo: amount,
-				Last:      eth.Last,
+				Hash:         eth.Hash,
+				UserId:       eth.UserId,
+				Amount:       eth.Amount,
+				AmountTwo:    eth.AmountTwo,
+				Decimals:     eth.Decimals,
+				Last:         eth.Last,
+				BlockNumber:  eth.BlockNumber,
+				ChainId:      eth.ChainId,
+				Contract:     eth.Contract,
+				DepositIndex: eth.DepositIndex,
+				Uncredited:   uncredited,
 			})
 			if nil != err {
 				return err
 			}
 		}
 
+		// 入账关闭时只记录，之后用补入账处理
+		if creditOpen {
+			err = uuc.depositCredit(ctx, userId, eth, recommendIds)
+			if nil != err {
+				return err
+			}
+		}
+
+		if nil != after {
+			return after(ctx)
+		}
+
 		return nil
 	}); nil != err {
-		fmt.Println(err, "错误投资3", userId, amount)
+		// 并发写入被唯一索引拦下，视为已入账
+		if 0 <= eth.DepositIndex && "" != eth.Contract {
+			record, _ = uuc.repo.GetEthUserRecordByIndex(eth.ChainId, eth.Contract, eth.DepositIndex)
+			if nil != record {
+				return uuc.depositAfter(ctx, after)
+			}
+		}
+
+		fmt.Println(err, "错误投资3", userId, eth.Amount)
 		return err
 	}
 
-	//// 推荐人
-	//var (
-	//	userRecommend       *UserRecommend
-	//	tmpRecommendUserIds []string
-	//)
-	//userRecommend, err = uuc.repo.GetUserRecommendByUserId(userId)
-	//if nil != err {
-	//	return err
-	//}
-	//if "" != userRecommend.RecommendCode {
-	//	tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
-	//}
-	//
-	//totalTmp := len(tmpRecommendUserIds) - 1
-	//for i := totalTmp; i >= 0; i-- {
-	//	tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
-	//	if 0 >= tmpUserId {
-	//		continue
-	//	}
-	//
-	//	// 增加业绩
-	//	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-	//		err = uuc.repo.UpdateUserMyTotalAmountAdd(ctx, tmpUserId, amount)
-	//		if err != nil {
-	//			return err
-	//		}
-	//
-	//		return nil
-	//	}); nil != err {
-	//		fmt.Println("遍历业绩：", err, tmpUserId, eth)
-	//		continue
-	//	}
-	//}
-
 	return nil
 }
 
-var lockHandle sync.Mutex
+func (uuc *UserUseCase) depositAfter(ctx context.Context, after func(ctx context.Context) error) error {
+	if nil == after {
+		return nil
+	}
 
-func (uuc *UserUseCase) OpenCardHandle(ctx context.Context) error {
-	lockHandle.Lock()
-	defer lockHandle.Unlock()
+	return uuc.tx.ExecTx(ctx, after)
+}
 
+// depositCredit 充值入账：加余额，写充值记录，推荐链加业绩，需在事务内调用
+func (uuc *UserUseCase) depositCredit(ctx context.Context, userId uint64, eth *EthUserRecord, recommendIds []uint64) error {
 	var (
-		userOpenCard []*User
-		err          error
+		err error
 	)
 
-	userOpenCard, err = uuc.repo.GetUsersOpenCard()
+	amount := eth.CreditAmount()
+	err = uuc.LedgerPost(ctx, NewJournal(pb.RewardReason_REWARD_REASON_DEPOSIT, eth.Hash).Move(AccountDeposit, UserAccount(userId), amount))
 	if nil != err {
 		return err
 	}
 
-	if 0 >= len(userOpenCard) {
-		return nil
+	err = uuc.repo.DepositCredit(ctx, userId, amount, eth.AmountTwo, eth.Hash)
+	if nil != err {
+		return err
 	}
 
-	//var (
-	//	products          *CardProductListResponse
-	//	productIdUse      string
-	//	productIdUseInt64 uint64
-	//	maxCardQuota      int
-	//)
-	//products, err = GetCardProducts()
-	//if nil == products || nil != err {
-	//	fmt.Println("产品信息错误1")
-	//	return nil
-	//}
-	//
-	//for _, v := range products.Rows {
-	//	if 0 < len(v.ProductId) && "ENABLED" == v.ProductStatus {
-	//		productIdUse = v.ProductId
-	//		maxCardQuota = v.MaxCardQuota
-	//		productIdUseInt64, err = strconv.ParseUint(productIdUse, 10, 64)
-	//		if nil != err {
-	//			fmt.Println("产品信息错误2")
-	//			return nil
-	//		}
-	//		fmt.Println("当前选择产品信息", productIdUse, maxCardQuota, v)
-	//		break
-	//	}
-	//}
-	//
-	//if 0 >= maxCardQuota {
-	//	fmt.Println("产品信息错误3")
-	//	return nil
-	//}
-	//
-	//if 0 >= productIdUseInt64 {
-	//	fmt.Println("产品信息错误4")
-	//	return nil
-	//}
-
-	for _, user := range userOpenCard {
-		//var (
-		//	resCreatCardholder *CreateCardholderResponse
-		//)
-		//resCreatCardholder, err = CreateCardholderRequest(productIdUseInt64, user)
-		//if nil == resCreatCardholder || 200 != resCreatCardholder.Code || err != nil {
-		//	fmt.Println("持卡人订单创建失败", user, resCreatCardholder, err)
-		//	continue
-		//}
-		//if 0 > len(resCreatCardholder.Data.HolderID) {
-		//	fmt.Println("持卡人订单信息错误", user, resCreatCardholder, err)
-		//	continue
-		//}
-		//fmt.Println("持卡人信息", user, resCreatCardholder)
-		//
-
-		var (
-			holderId          uint64
-			productIdUseInt64 uint64
-			resCreatCard      *CreateCardResponse
-			openRes           = true
-		)
-		if 5 > len(user.CardUserId) {
-			fmt.Println("持卡人id空", user)
-			openRes = false
-		}
-		holderId, err = strconv.ParseUint(user.CardUserId, 10, 64)
+	for _, vUserId := range recommendIds {
+		err = uuc.repo.UpdateUserMyTotalAmountAdd(ctx, vUserId, amount)
 		if nil != err {
-			fmt.Println("持卡人错误2")
-			openRes = false
-		}
-		if 0 >= holderId {
-			fmt.Println("持卡人错误3")
-			openRes = false
-		}
-		if 5 > len(user.CardUserId) {
-			fmt.Println("持卡人id空", user)
-			openRes = false
+			return err
 		}
+	}
 
-		if 0 >= user.MaxCardQuota {
-			fmt.Println("最大额度错误", user)
-			openRes = false
-		}
+	return nil
+}
 
-		if 5 > len(user.ProductId) {
-			fmt.Println("productid空", user)
-			openRes = false
-		}
-		productIdUseInt64, err = strconv.ParseUint(user.ProductId, 10, 64)
-		if nil != err {
-			fmt.Println("产品信息错误1")
-			openRes = false
-		}
-		if 0 >= productIdUseInt64 {
-			fmt.Println("产品信息错误2")
-			openRes = false
-		}
+// getDepositPipelineConfig 充值入账开关，未配置时入账开启、业绩关闭，与之前一致
+func (uuc *UserUseCase) getDepositPipelineConfig() (bool, bool) {
+	var (
+		configs    []*Config
+		creditOpen = true
+		teamOpen   = false
+	)
 
-		if !openRes {
-			fmt.Println("回滚了用户", user)
-			backAmount := float64(10)
-			if 0 < user.VipTwo {
-				backAmount = float64(30)
+	configs, _ = uuc.repo.GetConfigByKeys("deposit_credit", "deposit_team")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "deposit_credit" == vConfig.KeyName {
+				creditOpen = "1" == vConfig.Value
 			}
-			err = uuc.backCard(ctx, user.ID, backAmount)
-			if nil != err {
-				fmt.Println("回滚了用户失败", user, err)
+			if "deposit_team" == vConfig.KeyName {
+				teamOpen = "1" == vConfig.Value
 			}
+		}
+	}
+
+	return creditOpen, teamOpen
+}
+
+// getRecommendUserIds 推荐链上仍存在的用户，直推人在最后
+func (uuc *UserUseCase) getRecommendUserIds(userId uint64) ([]uint64, error) {
+	var (
+		userRecommend       *UserRecommend
+		tmpRecommendUserIds []string
+		recommendIds        []uint64
+		users               map[uint64]*User
+		err                 error
+	)
+
+	res := make([]uint64, 0)
+	userRecommend, err = uuc.repo.GetUserRecommendByUserId(userId)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == userRecommend || "" == userRecommend.RecommendCode {
+		return res, nil
+	}
 
+	tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+	for _, v := range tmpRecommendUserIds {
+		tmpUserId, _ := strconv.ParseUint(v, 10, 64)
+		if 0 >= tmpUserId {
 			continue
 		}
 
-		//
-		var (
-			resHolder *QueryCardHolderResponse
-		)
+		recommendIds = append(recommendIds, tmpUserId)
+	}
 
-		resHolder, err = QueryCardHolderWithSign(holderId, productIdUseInt64)
-		if nil == resHolder || err != nil || 200 != resHolder.Code {
-			fmt.Println(user, err, "持卡人信息请求错误", resHolder)
+	if 0 >= len(recommendIds) {
+		return res, nil
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(recommendIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, v := range recommendIds {
+		if _, ok := users[v]; !ok {
 			continue
 		}
 
-		if "active" == resHolder.Data.Status {
+		res = append(res, v)
+	}
 
-		} else if "pending" == resHolder.Data.Status {
-			continue
-		} else {
-			fmt.Println(user, err, "持卡人创建失败", resHolder)
-			backAmount := float64(10)
-			if 0 < user.VipTwo {
-				backAmount = float64(30)
+	return res, nil
+}
+
+// DepositSmall 低于最小充值金额的充值，按配置累计、直接入账或待审核，不影响后续充值
+func (uuc *UserUseCase) DepositSmall(ctx context.Context, userId uint64, address string, eth *EthUserRecord, minAmount *big.Int) error {
+	var (
+		pending *EthDepositPending
+		err     error
+	)
+
+	policy := uuc.getDepositSmallPolicy()
+	if "credit" == policy {
+		return uuc.DepositNew(ctx, userId, eth, false)
+	}
+
+	pending, err = uuc.repo.GetEthDepositPendingByIndex(eth.ChainId, eth.Contract, eth.DepositIndex)
+	if nil != err {
+		return err
+	}
+
+	// 区块回滚重扫时已记录过
+	if nil == pending {
+		status := "review"
+		if "accumulate" == policy {
+			status = "pending"
+		}
+
+		_, err = uuc.repo.CreateEthDepositPending(ctx, &EthDepositPending{
+			UserId:       int64(userId),
+			Address:      address,
+			Hash:         eth.Hash,
+			Amount:       eth.Amount,
+			Decimals:     eth.Decimals,
+			BlockNumber:  eth.BlockNumber,
+			ChainId:      eth.ChainId,
+			Contract:     eth.Contract,
+			DepositIndex: eth.DepositIndex,
+			Status:       status,
+		})
+		if nil != err {
+			return err
+		}
+	}
+
+	if "accumulate" != policy {
+		return nil
+	}
+
+	return uuc.sweepDepositPending(ctx, userId, eth.ChainId, eth.Contract, minAmount)
+}
+
+// sweepDepositPending 累计中的小额充值合计达到最小充值金额后逐笔入账
+func (uuc *UserUseCase) sweepDepositPending(ctx context.Context, userId uint64, chainId int64, contract string, minAmount *big.Int) error {
+	var (
+		pending []*EthDepositPending
+		err     error
+	)
+
+	pending, err = uuc.repo.GetEthDepositPendingByUser(int64(userId), chainId, contract, "pending")
+	if nil != err {
+		return err
+	}
+
+	total := new(big.Int)
+	for _, v := range pending {
+		total.Add(total, v.wei())
+	}
+
+	if 0 >= len(pending) || 0 < minAmount.Cmp(total) {
+		return nil
+	}
+
+	for _, v := range pending {
+		err = uuc.creditDepositPending(ctx, v, "pending", "累计达到最小充值")
+		if nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// creditDepositPending 小额充值入账和改状态在同一事务，充值记录按 hash 和下标去重，中断后可重复执行
+func (uuc *UserUseCase) creditDepositPending(ctx context.Context, v *EthDepositPending, from string, remark string) error {
+	return uuc.depositNew(ctx, uint64(v.UserId), &EthUserRecord{
+		UserId:       v.UserId,
+		Hash:         v.Hash,
+		Amount:       v.Amount,
+		AmountTwo:    ScaleAmount(v.wei(), v.Decimals, 0).Uint64(),
+		Decimals:     v.Decimals,
+		Last:         v.DepositIndex + 1,
+		BlockNumber:  v.BlockNumber,
+		ChainId:      v.ChainId,
+		Contract:     v.Contract,
+		DepositIndex: v.DepositIndex,
+	}, false, func(ctx context.Context) error {
+		return uuc.repo.UpdateEthDepositPendingStatus(ctx, v.ID, from, "credited", remark)
+	})
+}
+
+func (p *EthDepositPending) wei() *big.Int {
+	wei, ok := new(big.Int).SetString(p.Amount, 10)
+	if !ok {
+		return new(big.Int)
+	}
+
+	return wei
+}
+
+// getDepositSmallPolicy 小额充值策略 accumulate 累计，credit 直接入账，review 待审核，未配置时待审核
+func (uuc *UserUseCase) getDepositSmallPolicy() string {
+	var (
+		configs []*Config
+		policy  = "review"
+	)
+
+	configs, _ = uuc.repo.GetConfigByKeys("deposit_small_policy")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "deposit_small_policy" == vConfig.KeyName {
+				if "accumulate" == vConfig.Value || "credit" == vConfig.Value || "review" == vConfig.Value {
+					policy = vConfig.Value
+				}
 			}
-			err = uuc.backCard(ctx, user.ID, backAmount)
+		}
+	}
+
+	return policy
+}
+
+// AdminDepositSmallList 小额充值列表
+func (uuc *UserUseCase) AdminDepositSmallList(ctx context.Context, req *pb.AdminDepositSmallListRequest) (*pb.AdminDepositSmallListReply, error) {
+	var (
+		userSearch *User
+		userId     int64
+		pending    []*EthDepositPending
+		count      int64
+		err        error
+	)
+	res := &pb.AdminDepositSmallListReply{
+		List: make([]*pb.AdminDepositSmallListReply_List, 0),
+	}
+
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
+		}
+
+		userId = int64(userSearch.ID)
+	}
+
+	pending, err, count = uuc.repo.GetEthDepositPendingPage(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId, req.Status)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = uint64(count)
+
+	for _, v := range pending {
+		res.List = append(res.List, &pb.AdminDepositSmallListReply_List{
+			Id:        uint64(v.ID),
+			Address:   v.Address,
+			Hash:      v.Hash,
+			Amount:    WeiToDecimal(v.wei(), v.Decimals),
+			Index:     v.DepositIndex,
+			Status:    v.Status,
+			Remark:    v.Remark,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// AdminDepositSmallResolve 小额充值处理，累计中和待审核的记录可入账或驳回
+func (uuc *UserUseCase) AdminDepositSmallResolve(ctx context.Context, req *pb.AdminDepositSmallResolveRequest) (*pb.AdminDepositSmallResolveReply, error) {
+	var (
+		pending *EthDepositPending
+		err     error
+	)
+
+	if nil == req.SendBody {
+		return nil, errors.New(400, "PARAM_ERROR", "缺少参数")
+	}
+
+	pending, err = uuc.repo.GetEthDepositPendingById(int64(req.SendBody.Id))
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == pending {
+		return &pb.AdminDepositSmallResolveReply{Status: "记录不存在"}, nil
+	}
+
+	if "pending" != pending.Status && "review" != pending.Status {
+		return &pb.AdminDepositSmallResolveReply{Status: "记录已处理"}, nil
+	}
+
+	remark := req.SendBody.Remark
+	if "credit" == req.SendBody.Action {
+		if "" == remark {
+			remark = "后台入账"
+		}
+
+		err = uuc.creditDepositPending(ctx, pending, pending.Status, remark)
+		if nil != err {
+			return nil, err
+		}
+
+		return &pb.AdminDepositSmallResolveReply{Status: "ok"}, nil
+	}
+
+	if "reject" == req.SendBody.Action {
+		if "" == remark {
+			remark = "后台驳回"
+		}
+
+		err = uuc.repo.UpdateEthDepositPendingStatus(ctx, pending.ID, pending.Status, "rejected", remark)
+		if nil != err {
+			return nil, err
+		}
+
+		return &pb.AdminDepositSmallResolveReply{Status: "ok"}, nil
+	}
+
+	return &pb.AdminDepositSmallResolveReply{Status: "操作类型错误"}, nil
+}
+
+// AdminDepositReplay 补入账，处理入账关闭期间只记录未加余额的充值
+func (uuc *UserUseCase) AdminDepositReplay(ctx context.Context, req *pb.AdminDepositReplayRequest) (*pb.AdminDepositReplayReply, error) {
+	var (
+		records []*EthUserRecord
+		limit   = 100
+		err     error
+	)
+
+	if nil != req.SendBody && 0 < req.SendBody.Limit {
+		limit = int(req.SendBody.Limit)
+	}
+
+	records, err = uuc.repo.GetEthUserRecordsUncredited(limit)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &pb.AdminDepositReplayReply{
+		Total: uint64(len(records)),
+	}
+
+	_, teamOpen := uuc.getDepositPipelineConfig()
+	for _, v := range records {
+		var recommendIds []uint64
+		if teamOpen {
+			recommendIds, err = uuc.getRecommendUserIds(uint64(v.UserId))
 			if nil != err {
-				fmt.Println("回滚了用户失败", user, err)
+				fmt.Println("补入账推荐人错误", v.ID, err)
+				continue
 			}
-			continue
 		}
 
-		resCreatCard, err = CreateCardRequestWithSign(0, holderId, productIdUseInt64)
-		if nil == resCreatCard || 200 != resCreatCard.Code || err != nil {
-			fmt.Println("开卡订单创建失败", user, resCreatCard, err)
-			backAmount := float64(10)
-			if 0 < user.VipTwo {
-				backAmount = float64(30)
-			}
-			err = uuc.backCard(ctx, user.ID, backAmount)
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			// 先抢占标记，防止重复补入账
+			err = uuc.repo.UpdateEthUserRecordCredited(ctx, v.ID)
 			if nil != err {
-				fmt.Println("回滚了用户失败", user, err)
+				return err
 			}
+
+			return uuc.depositCredit(ctx, uint64(v.UserId), v, recommendIds)
+		}); nil != err {
+			fmt.Println("补入账错误", v.ID, err)
 			continue
 		}
-		fmt.Println("开卡信息：", user, resCreatCard)
 
-		if 0 >= len(resCreatCard.Data.CardID) || 0 >= len(resCreatCard.Data.CardOrderID) {
-			fmt.Println("开卡订单信息错误", resCreatCard, err)
-			backAmount := float64(10)
-			if 0 < user.VipTwo {
-				backAmount = float64(30)
+		res.Success++
+	}
+
+	return res, nil
+}
+
+// AdminDepositReconcile 充值对账，onChain 为合约下标 0 到 length-1 的全部记录
+func (uuc *UserUseCase) AdminDepositReconcile(ctx context.Context, req *pb.AdminDepositReconcileRequest, chainId int64, contract string, decimals uint32, minAmount *big.Int, onChain []*DepositOnChain) (*pb.AdminDepositReconcileReply, error) {
+	var (
+		records      []*EthUserRecord
+		users        map[string]*User
+		addresses    []string
+		addressesMap map[string]bool
+		err          error
+	)
+
+	records, err = uuc.repo.GetEthUserRecords(chainId, contract)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &pb.AdminDepositReconcileReply{
+		OnChainCount: uint64(len(onChain)),
+		RecordCount:  uint64(len(records)),
+		Items:        make([]*pb.AdminDepositReconcileReply_List, 0),
+	}
+
+	addressesMap = make(map[string]bool, 0)
+	for _, v := range onChain {
+		if addressesMap[v.Address] {
+			continue
+		}
+		addressesMap[v.Address] = true
+		addresses = append(addresses, v.Address)
+	}
+
+	users = make(map[string]*User, 0)
+	if 0 < len(addresses) {
+		users, err = uuc.repo.GetUserByAddresses(addresses...)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	var (
+		indexed = make(map[int64][]*EthUserRecord, 0)
+		hashes  = make(map[string][]*EthUserRecord, 0)
+		legacy  = make(map[int64][]*EthUserRecord, 0) // 无下标的旧记录，按用户分组
+	)
+	for _, v := range records {
+		if "" != v.Hash {
+			hashes[v.Hash] = append(hashes[v.Hash], v)
+		}
+
+		if 0 <= v.DepositIndex {
+			indexed[v.DepositIndex] = append(indexed[v.DepositIndex], v)
+		} else {
+			legacy[v.UserId] = append(legacy[v.UserId], v)
+		}
+	}
+
+	for hash, v := range hashes {
+		if 1 >= len(v) {
+			continue
+		}
+
+		for _, vRecord := range v[1:] {
+			res.Items = append(res.Items, &pb.AdminDepositReconcileReply_List{
+				Kind:         "duplicate",
+				Index:        vRecord.DepositIndex,
+				RecordAmount: vRecord.CreditAmount().String(),
+				RecordId:     vRecord.ID,
+				Hash:         hash,
+				Remark:       "同一交易多条记录",
+			})
+		}
+	}
+
+	for _, v := range onChain {
+		var userId int64 = -1
+		if _, ok := users[v.Address]; ok {
+			userId = int64(users[v.Address].ID)
+		}
+
+		tmpRecords := indexed[v.Index]
+		delete(indexed, v.Index)
+
+		if 0 >= len(tmpRecords) {
+			// 旧记录没有下标，按用户和金额匹配
+			matched := false
+			for k, vRecord := range legacy[userId] {
+				if 0 == v.Amount.Cmp(vRecord.wei(decimals)) {
+					legacy[userId] = append(legacy[userId][:k], legacy[userId][k+1:]...)
+					matched = true
+					break
+				}
 			}
-			err = uuc.backCard(ctx, user.ID, backAmount)
-			if nil != err {
-				fmt.Println("回滚了用户失败", user, err)
+
+			if matched {
+				res.MatchedCount++
+				continue
+			}
+
+			remark := "未入账"
+			if -1 == userId {
+				remark = "用户不存在"
+			} else if 0 < minAmount.Cmp(v.Amount) {
+				remark = "金额小于最小充值"
 			}
+
+			res.Items = append(res.Items, &pb.AdminDepositReconcileReply_List{
+				Kind:          "missing",
+				Index:         v.Index,
+				Address:       v.Address,
+				OnChainAmount: WeiToDecimal(v.Amount, decimals),
+				RecordId:      0,
+				Remark:        remark,
+			})
 			continue
 		}
 
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			err = uuc.repo.UpdateCard(ctx, user.ID, resCreatCard.Data.CardOrderID, resCreatCard.Data.CardID)
-			if nil != err {
-				return err
+		for k, vRecord := range tmpRecords {
+			if 0 < k {
+				res.Items = append(res.Items, &pb.AdminDepositReconcileReply_List{
+					Kind:          "duplicate",
+					Index:         v.Index,
+					Address:       v.Address,
+					OnChainAmount: WeiToDecimal(v.Amount, decimals),
+					RecordAmount:  vRecord.CreditAmount().String(),
+					RecordId:      vRecord.ID,
+					Hash:          vRecord.Hash,
+					Remark:        "同一下标多条记录",
+				})
+				continue
 			}
 
-			return nil
-		}); nil != err {
-			fmt.Println(err, "开卡后，写入mysql错误", err, user, resCreatCard)
-			return nil
+			if 0 != v.Amount.Cmp(vRecord.wei(decimals)) || userId != vRecord.UserId {
+				res.Items = append(res.Items, &pb.AdminDepositReconcileReply_List{
+					Kind:          "mismatch",
+					Index:         v.Index,
+					Address:       v.Address,
+					OnChainAmount: WeiToDecimal(v.Amount, decimals),
+					RecordAmount:  vRecord.CreditAmount().String(),
+					RecordId:      vRecord.ID,
+					Hash:          vRecord.Hash,
+					Remark:        "金额或用户不一致",
+				})
+				continue
+			}
+
+			res.MatchedCount++
+		}
+	}
+
+	// 库内有下标但链上不存在
+	for index, v := range indexed {
+		for _, vRecord := range v {
+			res.Items = append(res.Items, &pb.AdminDepositReconcileReply_List{
+				Kind:         "mismatch",
+				Index:        index,
+				RecordAmount: vRecord.CreditAmount().String(),
+				RecordId:     vRecord.ID,
+				Hash:         vRecord.Hash,
+				Remark:       "链上无此下标",
+			})
+		}
+	}
+
+	// 没匹配上的旧记录
+	for _, v := range legacy {
+		for _, vRecord := range v {
+			res.Items = append(res.Items, &pb.AdminDepositReconcileReply_List{
+				Kind:         "mismatch",
+				Index:        -1,
+				RecordAmount: vRecord.CreditAmount().String(),
+				RecordId:     vRecord.ID,
+				Hash:         vRecord.Hash,
+				Remark:       "旧记录链上无对应",
+			})
+		}
+	}
+
+	sort.SliceStable(res.Items, func(i, j int) bool {
+		return res.Items[i].Index < res.Items[j].Index
+	})
+
+	return res, nil
+}
+
+var lockHandle sync.Mutex
+
+func (uuc *UserUseCase) OpenCardHandle(ctx context.Context) error {
+	lockHandle.Lock()
+	defer lockHandle.Unlock()
+
+	var (
+		userOpenCard []*User
+		err          error
+	)
+
+	userOpenCard, err = uuc.repo.GetUsersOpenCard()
+	if nil != err {
+		return err
+	}
+
+	if 0 >= len(userOpenCard) {
+		return nil
+	}
+
+	//var (
+	//	products          *CardProductListResponse
+	//	productIdUse      string
+	//	productIdUseInt64 uint64
+	//	maxCardQuota      int
+	//)
+	//products, err = GetCardProducts()
+	//if nil == products || nil != err {
+	//	fmt.Println("产品信息错误1")
+	//	return nil
+	//}
+	//
+	//for _, v := range products.Rows {
+	//	if 0 < len(v.ProductId) && "ENABLED" == v.ProductStatus {
+	//		productIdUse = v.ProductId
+	//		maxCardQuota = v.MaxCardQuota
+	//		productIdUseInt64, err = strconv.ParseUint(productIdUse, 10, 64)
+	//		if nil != err {
+	//			fmt.Println("产品信息错误2")
+	//			return nil
+	//		}
+	//		fmt.Println("当前选择产品信息", productIdUse, maxCardQuota, v)
+	//		break
+	//	}
+	//}
+	//
+	//if 0 >= maxCardQuota {
+	//	fmt.Println("产品信息错误3")
+	//	return nil
+	//}
+	//
+	//if 0 >= productIdUseInt64 {
+	//	fmt.Println("产品信息错误4")
+	//	return nil
+	//}
+
+	for _, user := range userOpenCard {
+		//var (
+		//	resCreatCardholder *CreateCardholderResponse
+		//)
+		//resCreatCardholder, err = CreateCardholderRequest(productIdUseInt64, user)
+		//if nil == resCreatCardholder || 200 != resCreatCardholder.Code || err != nil {
+		//	fmt.Println("持卡人订单创建失败", user, resCreatCardholder, err)
+		//	continue
+		//}
+		//if 0 > len(resCreatCardholder.Data.HolderID) {
+		//	fmt.Println("持卡人订单信息错误", user, resCreatCardholder, err)
+		//	continue
+		//}
+		//fmt.Println("持卡人信息", user, resCreatCardholder)
+		//
+
+		var (
+			holderId          uint64
+			productIdUseInt64 uint64
+			resCreatCard      *ProviderCard
+			openRes           = true
+		)
+		if 5 > len(user.CardUserId) {
+			fmt.Println("持卡人id空", user)
+			openRes = false
+		}
+		holderId, err = strconv.ParseUint(user.CardUserId, 10, 64)
+		if nil != err {
+			fmt.Println("持卡人错误2")
+			openRes = false
+		}
+		if 0 >= holderId {
+			fmt.Println("持卡人错误3")
+			openRes = false
+		}
+		if 5 > len(user.CardUserId) {
+			fmt.Println("持卡人id空", user)
+			openRes = false
+		}
+
+		if 0 >= user.MaxCardQuota {
+			fmt.Println("最大额度错误", user)
+			openRes = false
+		}
+
+		if 5 > len(user.ProductId) {
+			fmt.Println("productid空", user)
+			openRes = false
+		}
+		productIdUseInt64, err = strconv.ParseUint(user.ProductId, 10, 64)
+		if nil != err {
+			fmt.Println("产品信息错误1")
+			openRes = false
+		}
+		if 0 >= productIdUseInt64 {
+			fmt.Println("产品信息错误2")
+			openRes = false
+		}
+
+		if !openRes {
+			fmt.Println("回滚了用户", user)
+			backAmount := money.New(10)
+			if 0 < user.VipTwo {
+				backAmount = money.New(30)
+			}
+			err = uuc.backCard(ctx, user.ID, backAmount)
+			if nil != err {
+				fmt.Println("回滚了用户失败", user, err)
+			}
+
+			continue
+		}
+
+		//
+		var (
+			resHolder *ProviderCardholder
+		)
+
+		provider := uuc.cardProvider(user.ProductId, CardProviderISPay)
+		resHolder, err = provider.GetCardholder(ctx, user.CardUserId, user.ProductId)
+		if nil == resHolder || err != nil {
+			fmt.Println(user, err, "持卡人信息请求错误", resHolder)
+			continue
+		}
+
+		if CardholderActive == resHolder.Status {
+
+		} else if CardholderPending == resHolder.Status {
+			continue
+		} else {
+			fmt.Println(user, err, "持卡人创建失败", resHolder)
+			backAmount := money.New(10)
+			if 0 < user.VipTwo {
+				backAmount = money.New(30)
+			}
+			err = uuc.backCard(ctx, user.ID, backAmount)
+			if nil != err {
+				fmt.Println("回滚了用户失败", user, err)
+			}
+			continue
+		}
+
+		resCreatCard, err = provider.IssueCard(ctx, &IssueCardReq{
+			CardholderId: user.CardUserId,
+			ProductId:    user.ProductId,
+		})
+		if nil == resCreatCard || err != nil {
+			fmt.Println("开卡订单创建失败", user, resCreatCard, err)
+			backAmount := money.New(10)
+			if 0 < user.VipTwo {
+				backAmount = money.New(30)
+			}
+			err = uuc.backCard(ctx, user.ID, backAmount)
+			if nil != err {
+				fmt.Println("回滚了用户失败", user, err)
+			}
+			continue
+		}
+		fmt.Println("开卡信息：", user, resCreatCard)
+
+		if 0 >= len(resCreatCard.Card.CardID) || 0 >= len(resCreatCard.OrderId) {
+			fmt.Println("开卡订单信息错误", resCreatCard, err)
+			backAmount := money.New(10)
+			if 0 < user.VipTwo {
+				backAmount = money.New(30)
+			}
+			err = uuc.backCard(ctx, user.ID, backAmount)
+			if nil != err {
+				fmt.Println("回滚了用户失败", user, err)
+			}
+			continue
+		}
+
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err = uuc.repo.UpdateCard(ctx, user.ID, resCreatCard.OrderId, resCreatCard.Card.CardID)
+			if nil != err {
+				return err
+			}
+
+			return nil
+		}); nil != err {
+			fmt.Println(err, "开卡后，写入mysql错误", err, user, resCreatCard)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+var cardStatusLockHandle sync.Mutex
+
+func (uuc *UserUseCase) CardStatusHandle(ctx context.Context) error {
+	cardStatusLockHandle.Lock()
+	defer cardStatusLockHandle.Unlock()
+
+	var (
+		userOpenCard []*User
+		err          error
+	)
+
+	userOpenCard, err = uuc.repo.GetUsersOpenCardStatusDoing()
+	if nil != err {
+		return err
+	}
+
+	var (
+		users    []*User
+		usersMap map[uint64]*User
+	)
+	users, err = uuc.repo.GetAllUsers()
+	if nil == users {
+		fmt.Println("用户无")
+		return nil
+	}
+
+	usersMap = make(map[uint64]*User, 0)
+	for _, vUsers := range users {
+		usersMap[vUsers.ID] = vUsers
+	}
+
+	if 0 >= len(userOpenCard) {
+		return nil
+	}
+
+	for _, user := range userOpenCard {
+		// 查询状态。成功分红
+		var (
+			resCard *ProviderCard
+		)
+		if 2 >= len(user.Card) {
+			continue
+		}
+
+		resCard, err = uuc.cardProvider(user.ProductId, CardProviderISPay).GetCard(ctx, user.Card)
+		if nil == resCard || err != nil {
+			fmt.Println(resCard, err)
+			continue
+		}
+
+		if "ACTIVE" == resCard.Card.Status {
+			fmt.Println("开卡状态，激活：", resCard, user.ID)
+			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+				err = uuc.repo.UpdateCardSucces(ctx, user.ID, resCard.Pan)
+				if err != nil {
+					return err
+				}
+
+				return nil
+			}); nil != err {
+				fmt.Println("err，开卡成功", err, user.ID)
+				continue
+			}
+		} else if "PENDING" == resCard.Card.Status || "PROGRESS" == resCard.Card.Status {
+			fmt.Println("开卡状态，待处理：", resCard, user.ID)
+			continue
+		} else {
+			fmt.Println("开卡状态，失败：", resCard, user.ID)
+			backAmount := money.New(10)
+			if 0 < user.VipTwo {
+				backAmount = money.New(30)
+			}
+			err = uuc.backCard(ctx, user.ID, backAmount)
+			if nil != err {
+				fmt.Println("回滚了用户失败", user, err)
+			}
+			continue
+		}
+
+		// 分红
+		var (
+			userRecommend *UserRecommend
+		)
+		tmpRecommendUserIds := make([]string, 0)
+		// 推荐
+		userRecommend, err = uuc.repo.GetUserRecommendByUserId(user.ID)
+		if nil == userRecommend {
+			fmt.Println(err, "信息错误", err, user)
+			return nil
+		}
+		if "" != userRecommend.RecommendCode {
+			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+		}
+
+		tmpTopVip := uint64(10)
+		if 30 == user.VipTwo {
+			tmpTopVip = 30
+		}
+		totalTmp := len(tmpRecommendUserIds) - 1
+		lastVip := uint64(0)
+		for i := totalTmp; i >= 0; i-- {
+			tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
+			if 0 >= tmpUserId {
+				continue
+			}
+
+			if _, ok := usersMap[tmpUserId]; !ok {
+				fmt.Println("开卡遍历，信息缺失：", tmpUserId)
+				continue
+			}
+
+			if usersMap[tmpUserId].VipTwo != user.VipTwo {
+				fmt.Println("开卡遍历，信息缺失，不是一个vip区域：", usersMap[tmpUserId], user)
+				continue
+			}
+
+			if tmpTopVip < usersMap[tmpUserId].Vip {
+				fmt.Println("开卡遍历，vip信息设置错误：", usersMap[tmpUserId], lastVip)
+				break
+			}
+
+			// 小于等于上一个级别，跳过
+			if usersMap[tmpUserId].Vip <= lastVip {
+				continue
+			}
+
+			tmpAmount := usersMap[tmpUserId].Vip - lastVip // 极差
+			lastVip = usersMap[tmpUserId].Vip
+
+			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+				err = uuc.LedgerPost(ctx, NewJournal(pb.RewardReason_REWARD_REASON_CARD_RECOMMEND, user.Address).Move(AccountReward, UserAccount(tmpUserId), money.New(int64(tmpAmount))))
+				if err != nil {
+					return err
+				}
+
+				err = uuc.repo.CreateCardRecommend(ctx, tmpUserId, money.New(int64(tmpAmount)), usersMap[tmpUserId].Vip, user.Address)
+				if err != nil {
+					return err
+				}
+
+				return nil
+			}); nil != err {
+				fmt.Println("err reward", err, user, usersMap[tmpUserId])
+			}
+		}
+	}
+
+	return nil
+}
+
+var cardTwoStatusLockHandle sync.Mutex
+
+func (uuc *UserUseCase) CardTwoStatusHandle(ctx context.Context) error {
+	cardTwoStatusLockHandle.Lock()
+	defer cardTwoStatusLockHandle.Unlock()
+
+	var (
+		userOpenCard []*Reward
+		err          error
+	)
+
+	var (
+		configs       []*Config
+		vipThreeThree money.Money
+		vipThreeTwo   money.Money
+		vipThreeOne   money.Money
+		vipThreeFour  money.Money
+		vipThreeFive  money.Money
+		cardTwo       uint64
+	)
+
+	// 配置
+	configs, err = uuc.repo.GetConfigByKeys("card_two", "new_vip_three_one", "new_vip_three_two", "new_vip_three_three", "new_vip_three_four", "new_vip_three_five")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "new_vip_three_three" == vConfig.KeyName {
+				vipThreeThree, _ = money.Parse(vConfig.Value)
+			}
+			if "new_vip_three_two" == vConfig.KeyName {
+				vipThreeTwo, _ = money.Parse(vConfig.Value)
+			}
+			if "new_vip_three_one" == vConfig.KeyName {
+				vipThreeOne, _ = money.Parse(vConfig.Value)
+			}
+			if "new_vip_three_four" == vConfig.KeyName {
+				vipThreeFour, _ = money.Parse(vConfig.Value)
+			}
+			if "new_vip_three_five" == vConfig.KeyName {
+				vipThreeFive, _ = money.Parse(vConfig.Value)
+			}
+			if "card_two" == vConfig.KeyName {
+				cardTwo, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	userOpenCard, err = uuc.repo.GetUserCardTwo()
+	if nil != err {
+		return err
+	}
+
+	if 0 >= len(userOpenCard) {
+		return nil
+	}
+
+	var (
+		users    []*User
+		usersMap map[uint64]*User
+	)
+	users, err = uuc.repo.GetAllUsers()
+	if nil == users {
+		fmt.Println("开卡2，用户无")
+		return nil
+	}
+
+	usersMap = make(map[uint64]*User, 0)
+	for _, vUsers := range users {
+		usersMap[vUsers.ID] = vUsers
+	}
+
+	if 0 >= len(userOpenCard) {
+		return nil
+	}
+
+	for _, userCard := range userOpenCard {
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err = uuc.repo.UpdateCardTwo(ctx, userCard.ID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		}); nil != err {
+			fmt.Println("err reward 2", err, userCard)
+			continue
+		}
+
+		if _, ok := usersMap[userCard.UserId]; !ok {
+			fmt.Println("开卡2，信息缺失：", userCard)
+			continue
+		}
+		user := usersMap[userCard.UserId]
+
+		// 分红
+		var (
+			userRecommend *UserRecommend
+		)
+		tmpRecommendUserIds := make([]string, 0)
+		// 推荐
+		userRecommend, err = uuc.repo.GetUserRecommendByUserId(user.ID)
+		if nil == userRecommend {
+			fmt.Println(err, "开卡2，信息错误", err, user)
+			return nil
+		}
+		if "" != userRecommend.RecommendCode {
+			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+		}
+
+		totalTmp := len(tmpRecommendUserIds) - 1
+		tmp := uint64(0)
+		for i := totalTmp; i >= 0; i-- {
+			tmp++
+			tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
+			if 0 >= tmpUserId {
+				continue
+			}
+
+			if _, ok := usersMap[tmpUserId]; !ok {
+				fmt.Println("开卡遍历，信息缺失：", tmpUserId)
+				continue
+			}
+
+			tmpAmount := money.New(int64(cardTwo))
+			if 1 == tmp {
+				tmpAmount = tmpAmount.Mul(vipThreeOne)
+			} else if 2 == tmp {
+				tmpAmount = tmpAmount.Mul(vipThreeTwo)
+			} else if 3 == tmp {
+				tmpAmount = tmpAmount.Mul(vipThreeThree)
+			} else if 4 == tmp {
+				tmpAmount = tmpAmount.Mul(vipThreeFour)
+			} else if 5 == tmp {
+				tmpAmount = tmpAmount.Mul(vipThreeFive)
+			} else {
+				break
+			}
+
+			if 0 < tmpAmount.Cmp(money.MustParse("0.0001")) {
+				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+					err = uuc.LedgerPost(ctx, NewJournal(pb.RewardReason_REWARD_REASON_CARD_TWO_RECOMMEND, user.Address).Move(AccountReward, UserAccount(tmpUserId), tmpAmount))
+					if err != nil {
+						return err
+					}
+
+					err = uuc.repo.CreateCardRecommendTwo(ctx, tmpUserId, tmpAmount, tmp, user.Address)
+					if err != nil {
+						return err
+					}
+
+					return nil
+				}); nil != err {
+					fmt.Println("err reward 2", err, user, usersMap[tmpUserId])
+				}
+			}
+		}
+
+		//tmpTopVip := uint64(7)
+		//totalTmp := len(tmpRecommendUserIds) - 1
+		//lastVip := uint64(0)
+		//lastAmount := uint64(0)
+		//for i := totalTmp; i >= 0; i-- {
+		//	if vipThreeSeven <= lastAmount {
+		//		break
+		//	}
+		//
+		//	tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
+		//	if 0 >= tmpUserId {
+		//		continue
+		//	}
+		//
+		//	if _, ok := usersMap[tmpUserId]; !ok {
+		//		fmt.Println("开卡2遍历，信息缺失：", tmpUserId)
+		//		continue
+		//	}
+		//
+		//	if tmpTopVip < usersMap[tmpUserId].VipThree {
+		//		fmt.Println("开卡2遍历，vip信息设置错误：", usersMap[tmpUserId], lastVip)
+		//		break
+		//	}
+		//
+		//	// 小于等于上一个级别，跳过
+		//	if usersMap[tmpUserId].VipThree <= lastVip {
+		//		continue
+		//	}
+		//	lastVip = usersMap[tmpUserId].VipThree
+		//
+		//	// 奖励
+		//	tmpAmount := uint64(0)
+		//	if 1 == usersMap[tmpUserId].VipThree {
+		//		if vipThreeOne <= lastAmount {
+		//			fmt.Println("开卡2遍历，vip奖励信息设置错误1：", usersMap[tmpUserId], lastVip, vipThreeOne, lastAmount)
+		//			continue
+		//		}
+		//
+		//		tmpAmount = vipThreeOne - lastAmount
+		//		lastAmount = vipThreeOne
+		//	} else if 2 == usersMap[tmpUserId].VipThree {
+		//		if vipThreeTwo <= lastAmount {
+		//			fmt.Println("开卡2遍历，vip奖励信息设置错误2：", usersMap[tmpUserId], lastVip, vipThreeTwo, lastAmount)
+		//			continue
+		//		}
+		//
+		//		tmpAmount = vipThreeTwo - lastAmount
+		//		lastAmount = vipThreeTwo
+		//	} else if 3 == usersMap[tmpUserId].VipThree {
+		//		if vipThreeThree <= lastAmount {
+		//			fmt.Println("开卡2遍历，vip奖励信息设置错误3：", usersMap[tmpUserId], lastVip, vipThreeThree, lastAmount)
+		//			continue
+		//		}
+		//
+		//		tmpAmount = vipThreeThree - lastAmount
+		//		lastAmount = vipThreeThree
+		//	} else if 4 == usersMap[tmpUserId].VipThree {
+		//		if vipThreeFour <= lastAmount {
+		//			fmt.Println("开卡2遍历，vip奖励信息设置错误4：", usersMap[tmpUserId], lastVip, vipThreeFour, lastAmount)
+		//			continue
+		//		}
+		//
+		//		tmpAmount = vipThreeFour - lastAmount
+		//		lastAmount = vipThreeFour
+		//	} else if 5 == usersMap[tmpUserId].VipThree {
+		//		if vipThreeFive <= lastAmount {
+		//			fmt.Println("开卡2遍历，vip奖励信息设置错误5：", usersMap[tmpUserId], lastVip, vipThreeFive, lastAmount)
+		//			continue
+		//		}
+		//
+		//		tmpAmount = vipThreeFive - lastAmount
+		//		lastAmount = vipThreeFive
+		//	} else if 6 == usersMap[tmpUserId].VipThree {
+		//		if vipThreeSix <= lastAmount {
+		//			fmt.Println("开卡2遍历，vip奖励信息设置错误6：", usersMap[tmpUserId], lastVip, vipThreeSix, lastAmount)
+		//			continue
+		//		}
+		//
+		//		tmpAmount = vipThreeSix - lastAmount
+		//		lastAmount = vipThreeSix
+		//	} else if 7 == usersMap[tmpUserId].VipThree {
+		//		if vipThreeSeven <= lastAmount {
+		//			fmt.Println("开卡2遍历，vip奖励信息设置错误7：", usersMap[tmpUserId], lastVip, vipThreeSeven, lastAmount)
+		//			continue
+		//		}
+		//
+		//		tmpAmount = vipThreeSeven - lastAmount
+		//		lastAmount = vipThreeSeven
+		//	} else {
+		//		continue
+		//	}
+		//
+		//	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		//		err = uuc.repo.CreateCardRecommendTwo(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
+		//		if err != nil {
+		//			return err
+		//		}
+		//
+		//		return nil
+		//	}); nil != err {
+		//		fmt.Println("err reward 2", err, user, usersMap[tmpUserId])
+		//	}
+		//}
+	}
+
+	return nil
+}
+
+func (uuc *UserUseCase) backCard(ctx context.Context, userId uint64, amount money.Money) error {
+	var (
+		err error
+	)
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err = uuc.LedgerPost(ctx, NewJournal(pb.RewardReason_REWARD_REASON_CARD_REFUND, "").Move(AccountCard, UserAccount(userId), amount))
+		if err != nil {
+			return err
+		}
+
+		err = uuc.repo.UpdateCardNo(ctx, userId, amount)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}); nil != err {
+		fmt.Println("err")
+		return err
+	}
+
+	return nil
+}
+
+func (uuc *UserUseCase) AllInfo(ctx context.Context, req *pb.AllInfoRequest) (*pb.AllInfoReply, error) {
+	feeAmount, err := uuc.repo.GetFeeTotal("withdraw_fee")
+	if nil != err {
+		fmt.Println("手续费合计查询失败", err)
+	}
+
+	return &pb.AllInfoReply{
+		TotalUser:          0,
+		TodayUser:          0,
+		TotalDeposit:       "0",
+		TodayDeposit:       "0",
+		ToAmount:           "0",
+		FeeAmount:          feeAmount.String(),
+		CardTotal:          0,
+		CardTwoTotal:       0,
+		CardRewardTotal:    0,
+		CardRewardTwoTotal: 0,
+		TodayWithdraw:      0,
+		TotalWithdraw:      0,
+		BalanceAll:         0,
+	}, nil
+}
+
+func (uuc *UserUseCase) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error) {
+	return uuc.repo.GetWithdrawPassOrRewardedFirst(ctx)
+}
+
+func (uuc *UserUseCase) GetUserByUserIds(userIds ...uint64) (map[uint64]*User, error) {
+	return uuc.repo.GetUserByUserIds(userIds...)
+}
+
+func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id uint64) (*Withdraw, error) {
+	return uuc.repo.UpdateWithdraw(ctx, id, "doing")
+}
+
+// UpdateWithdrawBroadcast 保存签好的交易，状态改为已广播，先记录再发送
+func (uuc *UserUseCase) UpdateWithdrawBroadcast(ctx context.Context, w *Withdraw) error {
+	w.Status = "broadcast"
+	return uuc.repo.UpdateWithdrawTx(ctx, w)
+}
+
+// UpdateWithdrawConfirmed 链上确认成功，记录实际 gas
+func (uuc *UserUseCase) UpdateWithdrawConfirmed(ctx context.Context, id uint64, gasUsed uint64, gasCost string) error {
+	return uuc.repo.UpdateWithdrawReceipt(ctx, id, "confirmed", gasUsed, gasCost)
+}
+
+// UpdateWithdrawFailed 链上执行失败或 nonce 已被其他交易使用，代币没有转出，状态和退款在一个事务里，执行失败的也记录实际 gas
+func (uuc *UserUseCase) UpdateWithdrawFailed(ctx context.Context, withdraw *Withdraw, gasUsed uint64, gasCost string) error {
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err := uuc.repo.UpdateWithdrawReceipt(ctx, withdraw.ID, "failed", gasUsed, gasCost)
+		if nil != err {
+			return err
+		}
+
+		return uuc.refundWithdraw(ctx, withdraw, pb.RewardReason_REWARD_REASON_WITHDRAW_FAILED_REFUND)
+	})
+}
+
+// FailWithdraw 无法发放的提现标记失败，只处理未领取的，余额不退，由后台核实后处理
+func (uuc *UserUseCase) FailWithdraw(ctx context.Context, w *Withdraw, remark string) error {
+	return uuc.repo.UpdateWithdrawReview(ctx, w.ID, w.Status, "failed", remark)
+}
+
+func (uuc *UserUseCase) GetWithdrawsBroadcast(limit int) ([]*Withdraw, error) {
+	return uuc.repo.GetWithdrawsByStatus("broadcast", limit)
+}
+
+func (uuc *UserUseCase) GetWithdrawsByStatus(status string, limit int) ([]*Withdraw, error) {
+	return uuc.repo.GetWithdrawsByStatus(status, limit)
+}
+
+func (uuc *UserUseCase) UpdateWithdrawStatus(ctx context.Context, id uint64, from, to string) error {
+	return uuc.repo.UpdateWithdrawStatus(ctx, id, from, to)
+}
+
+// RecoverWithdrawDoing 中断留下的 doing 改为 to，仍是 doing 时才改
+func (uuc *UserUseCase) RecoverWithdrawDoing(ctx context.Context, w *Withdraw, to string, remark string) error {
+	return uuc.repo.UpdateWithdrawReview(ctx, w.ID, "doing", to, remark)
+}
+
+// AllocateWithdrawNonce 热钱包下一个 nonce，优先用放回的，pending 为链上 pending nonce
+func (uuc *UserUseCase) AllocateWithdrawNonce(ctx context.Context, address string, pending uint64) (uint64, error) {
+	return uuc.repo.AllocateNonce(ctx, address, pending)
+}
+
+// ReleaseWithdrawNonce 交易未记录时放回 nonce
+func (uuc *UserUseCase) ReleaseWithdrawNonce(ctx context.Context, address string, nonce uint64) error {
+	return uuc.repo.ReleaseNonce(ctx, address, nonce)
+}
+
+// SyncWithdrawNonce 按链上 pending nonce 和已记录的提现交易重建 nonce，中间没被记录的都放回
+func (uuc *UserUseCase) SyncWithdrawNonce(ctx context.Context, address string, pending uint64) error {
+	var (
+		withdraws []*Withdraw
+		err       error
+	)
+
+	withdraws, err = uuc.repo.GetWithdrawsByStatus("broadcast", 10000)
+	if nil != err {
+		return err
+	}
+
+	used := make(map[uint64]bool, 0)
+	next := pending
+	for _, v := range withdraws {
+		if v.Nonce < pending {
+			continue
+		}
+
+		used[v.Nonce] = true
+		if v.Nonce+1 > next {
+			next = v.Nonce + 1
+		}
+	}
+
+	free := make([]uint64, 0)
+	for i := pending; i < next; i++ {
+		if !used[i] {
+			free = append(free, i)
+		}
+	}
+
+	return uuc.repo.ResetNonce(ctx, address, next, free)
+}
+
+// GetWithdrawFeeSchedule 手续费配置，withdraw_fee_vip 格式为 级别:比例，逗号分隔，如 1:0.01,2:0.008
+func (uuc *UserUseCase) GetWithdrawFeeSchedule() *WithdrawFeeSchedule {
+	var (
+		configs []*Config
+	)
+
+	res := &WithdrawFeeSchedule{
+		VipRates: make(map[uint64]money.Money, 0),
+	}
+
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_fee_flat", "withdraw_fee_rate", "withdraw_fee_vip", "withdraw_min_net")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_fee_flat" == vConfig.KeyName {
+				res.Flat, _ = money.Parse(vConfig.Value)
+			}
+			if "withdraw_fee_rate" == vConfig.KeyName {
+				res.Rate, _ = money.Parse(vConfig.Value)
+			}
+			if "withdraw_min_net" == vConfig.KeyName {
+				res.MinNet, _ = money.Parse(vConfig.Value)
+			}
+			if "withdraw_fee_vip" == vConfig.KeyName {
+				for _, v := range strings.Split(vConfig.Value, ",") {
+					tmp := strings.Split(strings.TrimSpace(v), ":")
+					if 2 != len(tmp) {
+						continue
+					}
+
+					vip, err := strconv.ParseUint(tmp[0], 10, 64)
+					if nil != err {
+						continue
+					}
+
+					rate, err := money.Parse(tmp[1])
+					if nil != err {
+						continue
+					}
+
+					res.VipRates[vip] = rate
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// Withdraw 提现，按手续费配置计算到账金额，手续费记到平台手续费账户
+func (uuc *UserUseCase) Withdraw(ctx context.Context, userId uint64, amount money.Money, address string) (*Withdraw, error) {
+	var (
+		user *User
+		fee  money.Money
+		net  money.Money
+		id   uint64
+		err  error
+	)
+
+	if 0 >= amount.Sign() {
+		return nil, errors.New(500, "WITHDRAW_AMOUNT_ERROR", "提现金额错误")
+	}
+
+	user, err = uuc.repo.GetUserById(userId)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == user {
+		return nil, errors.New(500, "USER_ERROR", "用户不存在")
+	}
+
+	fee, net, err = uuc.GetWithdrawFeeSchedule().Fee(amount, user.Vip)
+	if nil != err {
+		return nil, err
+	}
+
+	err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		id, err = uuc.repo.Withdraw(ctx, userId, amount, net, fee, address)
+		if nil != err {
+			return err
+		}
+
+		// 用户扣全额，到账部分进提现待付，手续费进手续费账户
+		j := NewJournal(pb.RewardReason_REWARD_REASON_WITHDRAW, "withdraw:"+strconv.FormatUint(id, 10))
+		j.Postings = append(j.Postings,
+			&Posting{Account: UserAccount(userId), Amount: amount.Neg()},
+			&Posting{Account: AccountWithdraw, Amount: amount.Sub(fee)},
+		)
+		if 0 < fee.Sign() {
+			j.Postings = append(j.Postings, &Posting{Account: AccountFee, Amount: fee})
+		}
+
+		return uuc.LedgerPost(ctx, j)
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return uuc.repo.GetWithdrawById(id)
+}
+
+// GetWithdrawReviewAmount 提现审核线，达到的要人工审核，0为全部自动发放
+func (uuc *UserUseCase) GetWithdrawReviewAmount() money.Money {
+	var (
+		configs   []*Config
+		threshold money.Money
+	)
+
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_review_amount")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_review_amount" == vConfig.KeyName {
+				threshold, _ = money.Parse(vConfig.Value)
+			}
+		}
+	}
+
+	return threshold
+}
+
+// NeedWithdrawReview 未经审核的提现金额达到审核线
+func NeedWithdrawReview(withdraw *Withdraw, threshold money.Money) bool {
+	return 0 < threshold.Sign() && 0 <= withdraw.Amount.Cmp(threshold)
+}
+
+// RouteWithdrawReview 金额达到审核线的待处理提现转为待审核，审核线为0时全部自动发放
+func (uuc *UserUseCase) RouteWithdrawReview(ctx context.Context, limit int) error {
+	var (
+		withdraws []*Withdraw
+		err       error
+	)
+
+	threshold := uuc.GetWithdrawReviewAmount()
+	if 0 >= threshold.Sign() {
+		return nil
+	}
+
+	withdraws, err = uuc.repo.GetWithdrawsByStatus("rewarded", limit)
+	if nil != err {
+		return err
+	}
+
+	for _, v := range withdraws {
+		if !NeedWithdrawReview(v, threshold) {
+			continue
+		}
+
+		err = uuc.repo.UpdateWithdrawReview(ctx, v.ID, "rewarded", "review", "超过审核金额")
+		if nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReviewWithdrawDoing 已领取的提现发送前发现达到审核线，转为待审核
+func (uuc *UserUseCase) ReviewWithdrawDoing(ctx context.Context, withdraw *Withdraw) error {
+	return uuc.repo.UpdateWithdrawReview(ctx, withdraw.ID, "doing", "review", "超过审核金额")
+}
+
+// getWithdrawDustPolicy 金额过小提现的处理，refund 退回余额，carry 并入下次提现，默认 refund
+func (uuc *UserUseCase) getWithdrawDustPolicy() string {
+	var (
+		configs []*Config
+	)
+
+	policy := "refund"
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_dust_policy")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_dust_policy" == vConfig.KeyName && "carry" == vConfig.Value {
+				policy = "carry"
+			}
+		}
+	}
+
+	return policy
+}
+
+// RejectWithdrawDust 金额过小不发放，按配置退回余额或留到下次提现一起发
+func (uuc *UserUseCase) RejectWithdrawDust(ctx context.Context, withdraw *Withdraw) error {
+	policy := uuc.getWithdrawDustPolicy()
+
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		if "carry" == policy {
+			return uuc.repo.UpdateWithdrawDust(ctx, withdraw.ID, "carry", "金额过小，并入下次提现")
+		}
+
+		err := uuc.repo.UpdateWithdrawDust(ctx, withdraw.ID, "refunded", "金额过小，已退回余额")
+		if nil != err {
+			return err
+		}
+
+		return uuc.refundWithdraw(ctx, withdraw, pb.RewardReason_REWARD_REASON_WITHDRAW_DUST_REFUND)
+	})
+}
+
+// refundWithdraw 未发放的提现退回余额，按扣款时的分录反向记账，并入这笔的金额过小提现一起退回，需在事务内调用
+func (uuc *UserUseCase) refundWithdraw(ctx context.Context, withdraw *Withdraw, reason pb.RewardReason) error {
+	err := uuc.postWithdrawRefund(ctx, withdraw, reason)
+	if nil != err {
+		return err
+	}
+
+	carried, err := uuc.repo.GetWithdrawsByCarryId(ctx, withdraw.ID)
+	if nil != err {
+		return err
+	}
+
+	for _, v := range carried {
+		err = uuc.repo.UpdateWithdrawDustRefund(ctx, v.ID, "carried", "并入的提现未发放，已退回余额")
+		if nil != err {
+			return err
+		}
+
+		err = uuc.postWithdrawRefund(ctx, v, pb.RewardReason_REWARD_REASON_WITHDRAW_DUST_REFUND)
+		if nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// postWithdrawRefund 一笔提现的退款分录和退款记录
+func (uuc *UserUseCase) postWithdrawRefund(ctx context.Context, withdraw *Withdraw, reason pb.RewardReason) error {
+	j := NewJournal(reason, "withdraw:"+strconv.FormatUint(withdraw.ID, 10))
+	j.Postings = append(j.Postings,
+		&Posting{Account: AccountWithdraw, Amount: withdraw.Amount.Sub(withdraw.Fee).Neg()},
+		&Posting{Account: UserAccount(withdraw.UserId), Amount: withdraw.Amount},
+	)
+	if 0 < withdraw.Fee.Sign() {
+		j.Postings = append(j.Postings, &Posting{Account: AccountFee, Amount: withdraw.Fee.Neg()})
+	}
+
+	err := uuc.LedgerPost(ctx, j)
+	if nil != err {
+		return err
+	}
+
+	return uuc.repo.WithdrawRefund(ctx, withdraw, reason)
+}
+
+// getWithdrawCarryDays 金额过小留到下次的提现最多等几天，超过退回余额，默认7天
+func (uuc *UserUseCase) getWithdrawCarryDays() int64 {
+	var (
+		configs []*Config
+	)
+
+	days := int64(7)
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_dust_carry_days")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_dust_carry_days" == vConfig.KeyName {
+				if tmp, err := strconv.ParseInt(vConfig.Value, 10, 64); nil == err && 0 <= tmp {
+					days = tmp
+				}
+			}
+		}
+	}
+
+	return days
+}
+
+// SweepWithdrawCarry 留到下次的金额过小提现，用户一直没有再提现或已改为 refund 的，退回余额
+func (uuc *UserUseCase) SweepWithdrawCarry(ctx context.Context, limit int) error {
+	var (
+		withdraws []*Withdraw
+		err       error
+	)
+
+	withdraws, err = uuc.repo.GetWithdrawsByDust("carry", limit)
+	if nil != err {
+		return err
+	}
+
+	carry := "carry" == uuc.getWithdrawDustPolicy()
+	expire := time.Duration(uuc.getWithdrawCarryDays()) * 24 * time.Hour
+	for _, v := range withdraws {
+		if carry && time.Since(v.UpdatedAt) < expire {
+			continue
+		}
+
+		err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err := uuc.repo.UpdateWithdrawDustRefund(ctx, v.ID, "carry", "金额过小，未并入提现，已退回余额")
+			if nil != err {
+				return err
+			}
+
+			return uuc.postWithdrawRefund(ctx, v, pb.RewardReason_REWARD_REASON_WITHDRAW_DUST_REFUND)
+		})
+		if nil != err {
+			return err
 		}
 	}
 
 	return nil
 }
 
-var cardStatusLockHandle sync.Mutex
-
-func (uuc *UserUseCase) CardStatusHandle(ctx context.Context) error {
-	cardStatusLockHandle.Lock()
-	defer cardStatusLockHandle.Unlock()
-
+// GetWithdrawResumeConfig 恢复提现的热钱包余额线，整数或小数个代币，0为余额够本批即恢复
+func (uuc *UserUseCase) GetWithdrawResumeConfig() (*big.Int, *big.Int) {
 	var (
-		userOpenCard []*User
-		err          error
+		configs []*Config
 	)
 
-	userOpenCard, err = uuc.repo.GetUsersOpenCardStatusDoing()
-	if nil != err {
-		return err
+	usdt := new(big.Int)
+	bnb := new(big.Int)
+
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_resume_usdt", "withdraw_resume_bnb")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_resume_usdt" == vConfig.KeyName {
+				usdt = DecimalToWei(vConfig.Value, 18)
+			}
+			if "withdraw_resume_bnb" == vConfig.KeyName {
+				bnb = DecimalToWei(vConfig.Value, 18)
+			}
+		}
 	}
 
+	return usdt, bnb
+}
+
+// CheckWithdrawBalance 发放前检查热钱包，usdt、bnb 为当前余额，needUsdt、needBnb 为本批需要的金额和 gas，返回能否发放
+func (uuc *UserUseCase) CheckWithdrawBalance(ctx context.Context, usdt, bnb, needUsdt, needBnb *big.Int) (bool, error) {
 	var (
-		users    []*User
-		usersMap map[uint64]*User
+		pause *WithdrawPause
+		err   error
 	)
-	users, err = uuc.repo.GetAllUsers()
-	if nil == users {
-		fmt.Println("用户无")
-		return nil
-	}
 
-	usersMap = make(map[uint64]*User, 0)
-	for _, vUsers := range users {
-		usersMap[vUsers.ID] = vUsers
+	pause, err = uuc.repo.GetWithdrawPause(ctx)
+	if nil != err {
+		return false, err
 	}
 
-	if 0 >= len(userOpenCard) {
-		return nil
+	if nil == pause {
+		pause = &WithdrawPause{}
 	}
 
-	for _, user := range userOpenCard {
-		// 查询状态。成功分红
-		var (
-			resCard *CardInfoResponse
-		)
-		if 2 >= len(user.Card) {
-			continue
-		}
-
-		resCard, err = GetCardInfoRequestWithSign(user.Card)
-		if nil == resCard || 200 != resCard.Code || err != nil {
-			fmt.Println(resCard, err)
-			continue
-		}
-
-		if "ACTIVE" == resCard.Data.CardStatus {
-			fmt.Println("开卡状态，激活：", resCard, user.ID)
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				err = uuc.repo.UpdateCardSucces(ctx, user.ID, resCard.Data.Pan)
-				if err != nil {
-					return err
-				}
+	pause.Usdt = WeiToDecimal(usdt, 18)
+	pause.Bnb = WeiToDecimal(bnb, 18)
+	pause.CheckedAt = time.Now()
 
-				return nil
-			}); nil != err {
-				fmt.Println("err，开卡成功", err, user.ID)
-				continue
-			}
-		} else if "PENDING" == resCard.Data.CardStatus || "PROGRESS" == resCard.Data.CardStatus {
-			fmt.Println("开卡状态，待处理：", resCard, user.ID)
-			continue
-		} else {
-			fmt.Println("开卡状态，失败：", resCard, user.ID)
-			backAmount := float64(10)
-			if 0 < user.VipTwo {
-				backAmount = float64(30)
-			}
-			err = uuc.backCard(ctx, user.ID, backAmount)
-			if nil != err {
-				fmt.Println("回滚了用户失败", user, err)
-			}
-			continue
+	// 暂停中，余额回到恢复线以上才恢复
+	if pause.Paused {
+		resumeUsdt, resumeBnb := uuc.GetWithdrawResumeConfig()
+		if 0 <= usdt.Cmp(resumeUsdt) && 0 <= bnb.Cmp(resumeBnb) {
+			fmt.Println("热钱包余额恢复，提现恢复", pause.Usdt, pause.Bnb)
+			pause.Paused = false
+			pause.Reason = ""
 		}
+	}
 
-		// 分红
-		var (
-			userRecommend *UserRecommend
-		)
-		tmpRecommendUserIds := make([]string, 0)
-		// 推荐
-		userRecommend, err = uuc.repo.GetUserRecommendByUserId(user.ID)
-		if nil == userRecommend {
-			fmt.Println(err, "信息错误", err, user)
-			return nil
-		}
-		if "" != userRecommend.RecommendCode {
-			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+	if !pause.Paused {
+		reason := ""
+		if 0 > usdt.Cmp(needUsdt) {
+			reason = "USDT余额不足，需要" + WeiToDecimal(needUsdt, 18)
+		} else if 0 > bnb.Cmp(needBnb) {
+			reason = "BNB余额不足支付gas，需要" + WeiToDecimal(needBnb, 18)
 		}
 
-		tmpTopVip := uint64(10)
-		if 30 == user.VipTwo {
-			tmpTopVip = 30
+		if "" != reason {
+			fmt.Println("热钱包余额不足，提现暂停", reason, pause.Usdt, pause.Bnb)
+			pause.Paused = true
+			pause.Reason = reason
+			pause.PausedAt = time.Now()
 		}
-		totalTmp := len(tmpRecommendUserIds) - 1
-		lastVip := uint64(0)
-		for i := totalTmp; i >= 0; i-- {
-			tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
-			if 0 >= tmpUserId {
-				continue
-			}
-
-			if _, ok := usersMap[tmpUserId]; !ok {
-				fmt.Println("开卡遍历，信息缺失：", tmpUserId)
-				continue
-			}
-
-			if usersMap[tmpUserId].VipTwo != user.VipTwo {
-				fmt.Println("开卡遍历，信息缺失，不是一个vip区域：", usersMap[tmpUserId], user)
-				continue
-			}
+	}
 
-			if tmpTopVip < usersMap[tmpUserId].Vip {
-				fmt.Println("开卡遍历，vip信息设置错误：", usersMap[tmpUserId], lastVip)
-				break
-			}
+	err = uuc.repo.SetWithdrawPause(ctx, pause)
+	if nil != err {
+		return false, err
+	}
 
-			// 小于等于上一个级别，跳过
-			if usersMap[tmpUserId].Vip <= lastVip {
-				continue
-			}
+	return !pause.Paused, nil
+}
 
-			tmpAmount := usersMap[tmpUserId].Vip - lastVip // 极差
-			lastVip = usersMap[tmpUserId].Vip
+// AdminWithdrawPause 提现队列暂停状态
+func (uuc *UserUseCase) AdminWithdrawPause(ctx context.Context, req *pb.AdminWithdrawPauseRequest) (*pb.AdminWithdrawPauseReply, error) {
+	pause, err := uuc.repo.GetWithdrawPause(ctx)
+	if nil != err {
+		return nil, err
+	}
 
-			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				err = uuc.repo.CreateCardRecommend(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
-				if err != nil {
-					return err
-				}
+	res := &pb.AdminWithdrawPauseReply{}
+	if nil == pause {
+		return res, nil
+	}
 
-				return nil
-			}); nil != err {
-				fmt.Println("err reward", err, user, usersMap[tmpUserId])
-			}
-		}
+	res.Paused = pause.Paused
+	res.Reason = pause.Reason
+	res.Usdt = pause.Usdt
+	res.Bnb = pause.Bnb
+	if !pause.PausedAt.IsZero() {
+		res.PausedAt = pause.PausedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+	}
+	if !pause.CheckedAt.IsZero() {
+		res.CheckedAt = pause.CheckedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
 	}
 
-	return nil
+	return res, nil
 }
 
-var cardTwoStatusLockHandle sync.Mutex
-
-func (uuc *UserUseCase) CardTwoStatusHandle(ctx context.Context) error {
-	cardTwoStatusLockHandle.Lock()
-	defer cardTwoStatusLockHandle.Unlock()
-
-	var (
-		userOpenCard []*Reward
-		err          error
-	)
-
+// AdminWithdrawList 提现列表
+func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *pb.AdminWithdrawListRequest) (*pb.AdminWithdrawListReply, error) {
 	var (
-		configs       []*Config
-		vipThreeThree float64
-		vipThreeTwo   float64
-		vipThreeOne   float64
-		vipThreeFour  float64
-		vipThreeFive  float64
-		cardTwo       uint64
+		userSearch *User
+		userId     uint64
+		withdraws  []*Withdraw
+		userIds    []uint64
+		users      map[uint64]*User
+		count      int64
+		err        error
 	)
+	res := &pb.AdminWithdrawListReply{
+		List: make([]*pb.AdminWithdrawListReply_List, 0),
+	}
 
-	// 配置
-	configs, err = uuc.repo.GetConfigByKeys("card_two", "new_vip_three_one", "new_vip_three_two", "new_vip_three_three", "new_vip_three_four", "new_vip_three_five")
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "new_vip_three_three" == vConfig.KeyName {
-				vipThreeThree, _ = strconv.ParseFloat(vConfig.Value, 10)
-			}
-			if "new_vip_three_two" == vConfig.KeyName {
-				vipThreeTwo, _ = strconv.ParseFloat(vConfig.Value, 10)
-			}
-			if "new_vip_three_one" == vConfig.KeyName {
-				vipThreeOne, _ = strconv.ParseFloat(vConfig.Value, 10)
-			}
-			if "new_vip_three_four" == vConfig.KeyName {
-				vipThreeFour, _ = strconv.ParseFloat(vConfig.Value, 10)
-			}
-			if "new_vip_three_five" == vConfig.KeyName {
-				vipThreeFive, _ = strconv.ParseFloat(vConfig.Value, 10)
-			}
-			if "card_two" == vConfig.KeyName {
-				cardTwo, _ = strconv.ParseUint(vConfig.Value, 10, 64)
-			}
+	// 地址查询
+	if "" != req.Address {
+		userSearch, err = uuc.repo.GetUserByAddress(req.Address)
+		if nil != err || nil == userSearch {
+			return res, nil
 		}
+
+		userId = userSearch.ID
 	}
 
-	userOpenCard, err = uuc.repo.GetUserCardTwo()
+	withdraws, err, count = uuc.repo.GetWithdrawPage(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId, req.Status)
 	if nil != err {
-		return err
+		return res, nil
 	}
+	res.Count = uint64(count)
 
-	if 0 >= len(userOpenCard) {
-		return nil
+	for _, v := range withdraws {
+		userIds = append(userIds, v.UserId)
+	}
+
+	users, err = uuc.repo.GetUserByUserIds(userIds...)
+	for _, v := range withdraws {
+		tmpUser := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpUser = users[v.UserId].Address
+			}
+		}
+
+		gasCost := ""
+		if "" != v.GasCost {
+			tmp, ok := new(big.Int).SetString(v.GasCost, 10)
+			if ok {
+				gasCost = WeiToDecimal(tmp, 18)
+			}
+		}
+
+		res.List = append(res.List, &pb.AdminWithdrawListReply_List{
+			Id:        v.ID,
+			Address:   tmpUser,
+			ToAddress: v.Address,
+			Amount:    v.Amount.StringFixed(2),
+			RelAmount: v.RelAmount.StringFixed(2),
+			Status:    v.Status,
+			TxHash:    v.TxHash,
+			Remark:    v.Remark,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			GasUsed:   v.GasUsed,
+			GasCost:   gasCost,
+		})
 	}
 
+	return res, nil
+}
+
+// AdminWithdrawReview 提现审核，驳回时状态、退款和退款记录在一个事务里
+func (uuc *UserUseCase) AdminWithdrawReview(ctx context.Context, req *pb.AdminWithdrawReviewRequest) (*pb.AdminWithdrawReviewReply, error) {
 	var (
-		users    []*User
-		usersMap map[uint64]*User
+		withdraw *Withdraw
+		err      error
 	)
-	users, err = uuc.repo.GetAllUsers()
-	if nil == users {
-		fmt.Println("开卡2，用户无")
-		return nil
+
+	if nil == req.SendBody {
+		return nil, errors.New(400, "PARAM_ERROR", "缺少参数")
 	}
 
-	usersMap = make(map[uint64]*User, 0)
-	for _, vUsers := range users {
-		usersMap[vUsers.ID] = vUsers
+	withdraw, err = uuc.repo.GetWithdrawById(req.SendBody.Id)
+	if nil != err {
+		return nil, err
 	}
 
-	if 0 >= len(userOpenCard) {
-		return nil
+	if nil == withdraw {
+		return &pb.AdminWithdrawReviewReply{Status: "提现不存在"}, nil
 	}
 
-	for _, userCard := range userOpenCard {
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			err = uuc.repo.UpdateCardTwo(ctx, userCard.ID)
-			if err != nil {
-				return err
-			}
+	remark := req.SendBody.Remark
+	if "approve" == req.SendBody.Action {
+		if "review" != withdraw.Status {
+			return &pb.AdminWithdrawReviewReply{Status: "不是待审核状态"}, nil
+		}
 
-			return nil
-		}); nil != err {
-			fmt.Println("err reward 2", err, userCard)
-			continue
+		if "" == remark {
+			remark = "审核通过"
 		}
 
-		if _, ok := usersMap[userCard.UserId]; !ok {
-			fmt.Println("开卡2，信息缺失：", userCard)
-			continue
+		err = uuc.repo.UpdateWithdrawReview(ctx, withdraw.ID, "review", "pass", remark)
+		if nil != err {
+			return nil, err
 		}
-		user := usersMap[userCard.UserId]
 
-		// 分红
-		var (
-			userRecommend *UserRecommend
-		)
-		tmpRecommendUserIds := make([]string, 0)
-		// 推荐
-		userRecommend, err = uuc.repo.GetUserRecommendByUserId(user.ID)
-		if nil == userRecommend {
-			fmt.Println(err, "开卡2，信息错误", err, user)
-			return nil
+		return &pb.AdminWithdrawReviewReply{Status: "ok"}, nil
+	}
+
+	if "reject" == req.SendBody.Action {
+		// 已领取发送的不能驳回
+		if "review" != withdraw.Status && "pass" != withdraw.Status && "rewarded" != withdraw.Status {
+			return &pb.AdminWithdrawReviewReply{Status: "当前状态不能驳回"}, nil
 		}
-		if "" != userRecommend.RecommendCode {
-			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+
+		if "" == remark {
+			remark = "审核驳回"
 		}
 
-		totalTmp := len(tmpRecommendUserIds) - 1
-		tmp := uint64(0)
-		for i := totalTmp; i >= 0; i-- {
-			tmp++
-			tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
-			if 0 >= tmpUserId {
-				continue
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err = uuc.repo.UpdateWithdrawReview(ctx, withdraw.ID, withdraw.Status, "rejected", remark)
+			if nil != err {
+				return err
 			}
 
-			if _, ok := usersMap[tmpUserId]; !ok {
-				fmt.Println("开卡遍历，信息缺失：", tmpUserId)
+			return uuc.refundWithdraw(ctx, withdraw, pb.RewardReason_REWARD_REASON_WITHDRAW_REJECT_REFUND)
+		}); nil != err {
+			fmt.Println(err, "提现驳回失败", withdraw.ID)
+			return nil, err
+		}
+
+		return &pb.AdminWithdrawReviewReply{Status: "ok"}, nil
+	}
+
+	return &pb.AdminWithdrawReviewReply{Status: "操作类型错误"}, nil
+}
+
+// GetWithdrawSendConfig 并发数、每次领取条数、gas 上限
+func (uuc *UserUseCase) GetWithdrawSendConfig() (int, int, uint64) {
+	var (
+		configs  []*Config
+		workers         = 5
+		batch           = 50
+		gasLimit uint64 = 100000
+	)
+
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_workers", "withdraw_batch", "withdraw_gas_limit")
+	if nil != configs {
+		for _, vConfig := range configs {
+			tmp, err := strconv.ParseUint(vConfig.Value, 10, 64)
+			if nil != err || 0 >= tmp {
 				continue
 			}
 
-			tmpAmount := float64(cardTwo)
-			if 1 == tmp {
-				tmpAmount *= vipThreeOne
-			} else if 2 == tmp {
-				tmpAmount *= vipThreeTwo
-			} else if 3 == tmp {
-				tmpAmount *= vipThreeThree
-			} else if 4 == tmp {
-				tmpAmount *= vipThreeFour
-			} else if 5 == tmp {
-				tmpAmount *= vipThreeFive
-			} else {
-				break
+			if "withdraw_workers" == vConfig.KeyName {
+				workers = int(tmp)
 			}
-
-			if 0.0001 < tmpAmount {
-				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					err = uuc.repo.CreateCardRecommendTwo(ctx, tmpUserId, tmpAmount, tmp, user.Address)
-					if err != nil {
-						return err
-					}
-
-					return nil
-				}); nil != err {
-					fmt.Println("err reward 2", err, user, usersMap[tmpUserId])
-				}
+			if "withdraw_batch" == vConfig.KeyName {
+				batch = int(tmp)
+			}
+			if "withdraw_gas_limit" == vConfig.KeyName {
+				gasLimit = tmp
 			}
 		}
-
-		//tmpTopVip := uint64(7)
-		//totalTmp := len(tmpRecommendUserIds) - 1
-		//lastVip := uint64(0)
-		//lastAmount := uint64(0)
-		//for i := totalTmp; i >= 0; i-- {
-		//	if vipThreeSeven <= lastAmount {
-		//		break
-		//	}
-		//
-		//	tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
-		//	if 0 >= tmpUserId {
-		//		continue
-		//	}
-		//
-		//	if _, ok := usersMap[tmpUserId]; !ok {
-		//		fmt.Println("开卡2遍历，信息缺失：", tmpUserId)
-		//		continue
-		//	}
-		//
-		//	if tmpTopVip < usersMap[tmpUserId].VipThree {
-		//		fmt.Println("开卡2遍历，vip信息设置错误：", usersMap[tmpUserId], lastVip)
-		//		break
-		//	}
-		//
-		//	// 小于等于上一个级别，跳过
-		//	if usersMap[tmpUserId].VipThree <= lastVip {
-		//		continue
-		//	}
-		//	lastVip = usersMap[tmpUserId].VipThree
-		//
-		//	// 奖励
-		//	tmpAmount := uint64(0)
-		//	if 1 == usersMap[tmpUserId].VipThree {
-		//		if vipThreeOne <= lastAmount {
-		//			fmt.Println("开卡2遍历，vip奖励信息设置错误1：", usersMap[tmpUserId], lastVip, vipThreeOne, lastAmount)
-		//			continue
-		//		}
-		//
-		//		tmpAmount = vipThreeOne - lastAmount
-		//		lastAmount = vipThreeOne
-		//	} else if 2 == usersMap[tmpUserId].VipThree {
-		//		if vipThreeTwo <= lastAmount {
-		//			fmt.Println("开卡2遍历，vip奖励信息设置错误2：", usersMap[tmpUserId], lastVip, vipThreeTwo, lastAmount)
-		//			continue
-		//		}
-		//
-		//		tmpAmount = vipThreeTwo - lastAmount
-		//		lastAmount = vipThreeTwo
-		//	} else if 3 == usersMap[tmpUserId].VipThree {
-		//		if vipThreeThree <= lastAmount {
-		//			fmt.Println("开卡2遍历，vip奖励信息设置错误3：", usersMap[tmpUserId], lastVip, vipThreeThree, lastAmount)
-		//			continue
-		//		}
-		//
-		//		tmpAmount = vipThreeThree - lastAmount
-		//		lastAmount = vipThreeThree
-		//	} else if 4 == usersMap[tmpUserId].VipThree {
-		//		if vipThreeFour <= lastAmount {
-		//			fmt.Println("开卡2遍历，vip奖励信息设置错误4：", usersMap[tmpUserId], lastVip, vipThreeFour, lastAmount)
-		//			continue
-		//		}
-		//
-		//		tmpAmount = vipThreeFour - lastAmount
-		//		lastAmount = vipThreeFour
-		//	} else if 5 == usersMap[tmpUserId].VipThree {
-		//		if vipThreeFive <= lastAmount {
-		//			fmt.Println("开卡2遍历，vip奖励信息设置错误5：", usersMap[tmpUserId], lastVip, vipThreeFive, lastAmount)
-		//			continue
-		//		}
-		//
-		//		tmpAmount = vipThreeFive - lastAmount
-		//		lastAmount = vipThreeFive
-		//	} else if 6 == usersMap[tmpUserId].VipThree {
-		//		if vipThreeSix <= lastAmount {
-		//			fmt.Println("开卡2遍历，vip奖励信息设置错误6：", usersMap[tmpUserId], lastVip, vipThreeSix, lastAmount)
-		//			continue
-		//		}
-		//
-		//		tmpAmount = vipThreeSix - lastAmount
-		//		lastAmount = vipThreeSix
-		//	} else if 7 == usersMap[tmpUserId].VipThree {
-		//		if vipThreeSeven <= lastAmount {
-		//			fmt.Println("开卡2遍历，vip奖励信息设置错误7：", usersMap[tmpUserId], lastVip, vipThreeSeven, lastAmount)
-		//			continue
-		//		}
-		//
-		//		tmpAmount = vipThreeSeven - lastAmount
-		//		lastAmount = vipThreeSeven
-		//	} else {
-		//		continue
-		//	}
-		//
-		//	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-		//		err = uuc.repo.CreateCardRecommendTwo(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
-		//		if err != nil {
-		//			return err
-		//		}
-		//
-		//		return nil
-		//	}); nil != err {
-		//		fmt.Println("err reward 2", err, user, usersMap[tmpUserId])
-		//	}
-		//}
 	}
 
-	return nil
+	return workers, batch, gasLimit
 }
 
-func (uuc *UserUseCase) backCard(ctx context.Context, userId uint64, amount float64) error {
+// GetWithdrawGasConfig gas 定价，withdraw_gas_mode 为 legacy 或 eip1559，
+// withdraw_gas_max_gwei 单价上限，0不限制，倍数按百分比，用量上限沿用 withdraw_gas_limit
+func (uuc *UserUseCase) GetWithdrawGasConfig() *gas.Config {
 	var (
-		err error
+		configs []*Config
 	)
-	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-		err = uuc.repo.UpdateCardNo(ctx, userId, amount)
-		if err != nil {
-			return err
-		}
 
-		return nil
-	}); nil != err {
-		fmt.Println("err")
-		return err
+	_, _, gasLimit := uuc.GetWithdrawSendConfig()
+	res := &gas.Config{
+		Mode:            gas.ModeLegacy,
+		PriceMultiplier: 100,
+		LimitMultiplier: 120,
+		MaxLimit:        gasLimit,
 	}
 
-	return nil
-}
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_gas_mode", "withdraw_gas_max_gwei", "withdraw_gas_price_multiplier", "withdraw_gas_limit_multiplier")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_gas_mode" == vConfig.KeyName && gas.ModeEip1559 == vConfig.Value {
+				res.Mode = gas.ModeEip1559
+			}
+			if "withdraw_gas_max_gwei" == vConfig.KeyName {
+				res.MaxPrice = DecimalToWei(vConfig.Value, 9)
+			}
 
-func (uuc *UserUseCase) AllInfo(ctx context.Context, req *pb.AllInfoRequest) (*pb.AllInfoReply, error) {
-	return &pb.AllInfoReply{
-		TotalUser:          0,
-		TodayUser:          0,
-		TotalDeposit:       0,
-		TodayDeposit:       0,
-		ToAmount:           0,
-		FeeAmount:          0,
-		CardTotal:          0,
-		CardTwoTotal:       0,
-		CardRewardTotal:    0,
-		CardRewardTwoTotal: 0,
-		TodayWithdraw:      0,
-		TotalWithdraw:      0,
-		BalanceAll:         0,
-	}, nil
-}
+			tmp, err := strconv.ParseUint(vConfig.Value, 10, 64)
+			if nil != err || 0 >= tmp {
+				continue
+			}
 
-func (uuc *UserUseCase) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error) {
-	return uuc.repo.GetWithdrawPassOrRewardedFirst(ctx)
-}
+			if "withdraw_gas_price_multiplier" == vConfig.KeyName {
+				res.PriceMultiplier = tmp
+			}
+			if "withdraw_gas_limit_multiplier" == vConfig.KeyName {
+				res.LimitMultiplier = tmp
+			}
+		}
+	}
 
-func (uuc *UserUseCase) GetUserByUserIds(userIds ...uint64) (map[uint64]*User, error) {
-	return uuc.repo.GetUserByUserIds(userIds...)
+	return res
 }
 
-func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id uint64) (*Withdraw, error) {
-	return uuc.repo.UpdateWithdraw(ctx, id, "doing")
-}
+// GetWithdrawWatchConfig 确认块数、多久未上链算卡住、最多重发次数
+func (uuc *UserUseCase) GetWithdrawWatchConfig() (uint64, time.Duration, uint64) {
+	var (
+		configs       []*Config
+		confirmations uint64 = 15
+		stuck                = 180 * time.Second
+		maxAttempts   uint64 = 5
+	)
+
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_confirmations", "withdraw_stuck_seconds", "withdraw_max_rebroadcast")
+	if nil != configs {
+		for _, vConfig := range configs {
+			tmp, err := strconv.ParseUint(vConfig.Value, 10, 64)
+			if nil != err {
+				continue
+			}
+
+			if "withdraw_confirmations" == vConfig.KeyName {
+				confirmations = tmp
+			}
+			if "withdraw_stuck_seconds" == vConfig.KeyName && 0 < tmp {
+				stuck = time.Duration(tmp) * time.Second
+			}
+			if "withdraw_max_rebroadcast" == vConfig.KeyName {
+				maxAttempts = tmp
+			}
+		}
+	}
 
-func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id uint64) (*Withdraw, error) {
-	return uuc.repo.UpdateWithdraw(ctx, id, "success")
+	return confirmations, stuck, maxAttempts
 }
 
 func (uuc *UserUseCase) EmailGet(ctx context.Context, req *pb.EmailGetRequest) (*pb.EmailGetReply, error) {
@@ -1111,6 +2828,82 @@ func (uuc *UserUseCase) AdminLogin(ctx context.Context, req *pb.AdminLoginReques
 	return res, nil
 }
 
+// AdminRewardReasonList 全部原因和文案，按编号排序
+func (uuc *UserUseCase) AdminRewardReasonList(ctx context.Context, req *pb.AdminRewardReasonListRequest) (*pb.AdminRewardReasonListReply, error) {
+	res := &pb.AdminRewardReasonListReply{
+		Reasons: make([]*pb.AdminRewardReasonListReply_List, 0),
+	}
+
+	values := make([]int32, 0, len(pb.RewardReason_name))
+	for k := range pb.RewardReason_name {
+		if int32(pb.RewardReason_REWARD_REASON_UNSPECIFIED) == k {
+			continue
+		}
+		values = append(values, k)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	lang := strings.ToLower(req.Lang)
+	for _, v := range values {
+		res.Reasons = append(res.Reasons, &pb.AdminRewardReasonListReply_List{
+			Reason: pb.RewardReason(v),
+			Value:  uint64(v),
+			Label:  RewardReasonLabel(pb.RewardReason(v), lang),
+		})
+	}
+
+	return res, nil
+}
+
+// UserStatementByAddress 按地址和日期查对账单，后台接口和 CSV 导出共用
+func (uuc *UserUseCase) UserStatementByAddress(ctx context.Context, address, start, end string, open func(money.Money) error, fn func([]*StatementLine) error) (money.Money, money.Money, error) {
+	user, err := uuc.repo.GetUserByAddress(address)
+	if nil != err {
+		return money.Zero(), money.Zero(), err
+	}
+	if nil == user {
+		return money.Zero(), money.Zero(), errors.New(500, "USER_ERROR", "用户不存在")
+	}
+
+	startTime, endTime, err := ParseStatementRange(start, end)
+	if nil != err {
+		return money.Zero(), money.Zero(), err
+	}
+
+	return uuc.UserStatement(ctx, user.ID, startTime, endTime, open, fn)
+}
+
+// AdminUserStatement 用户对账单，每笔收支按时间顺序带余额
+func (uuc *UserUseCase) AdminUserStatement(ctx context.Context, req *pb.AdminUserStatementRequest) (*pb.AdminUserStatementReply, error) {
+	res := &pb.AdminUserStatementReply{
+		List: make([]*pb.AdminUserStatementReply_List, 0),
+	}
+
+	opening, closing, err := uuc.UserStatementByAddress(ctx, req.Address, req.Start, req.End, nil, func(lines []*StatementLine) error {
+		for _, v := range lines {
+			res.List = append(res.List, &pb.AdminUserStatementReply_List{
+				Id:        v.ID,
+				CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+				Reason:    v.Reason,
+				Label:     RewardReasonLabel(v.Reason, "zh"),
+				Ref:       v.Ref,
+				Remark:    v.Remark,
+				Amount:    v.Amount.String(),
+				Balance:   v.Balance.String(),
+			})
+		}
+
+		return nil
+	})
+	if nil != err {
+		return res, err
+	}
+
+	res.Opening = opening.String()
+	res.Closing = closing.String()
+	return res, nil
+}
+
 func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *pb.AdminRewardListRequest) (*pb.AdminRewardListReply, error) {
 	var (
 		userSearch  *User
@@ -1164,7 +2957,7 @@ func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *pb.AdminReward
 
 		res.Rewards = append(res.Rewards, &pb.AdminRewardListReply_List{
 			CreatedAt:  vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-			Amount:     fmt.Sprintf("%.2f", vUserReward.Amount),
+			Amount:     vUserReward.Amount.StringFixed(2),
 			Address:    tmpUser,
 			Reason:     vUserReward.Reason,
 			AddressTwo: vUserReward.Address,
@@ -1292,13 +3085,13 @@ func (uuc *UserUseCase) AdminUserList(ctx context.Context, req *pb.AdminUserList
 			UserId:             vUsers.ID,
 			CreatedAt:          vUsers.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 			Address:            vUsers.Address,
-			Amount:             fmt.Sprintf("%.2f", vUsers.Amount),
+			Amount:             vUsers.Amount.StringFixed(2),
 			Vip:                vUsers.Vip,
 			CanVip:             vUsers.CanVip,
 			VipThree:           vUsers.VipThree,
 			MyRecommendAddress: addressMyRecommend,
 			HistoryRecommend:   lenUsers,
-			MyTotalAmount:      uint64(vUsers.MyTotalAmount),
+			MyTotalAmount:      uint64(vUsers.MyTotalAmount.Float64()),
 			CardNumber:         vUsers.CardNumber,
 			CardTwoNumber:      vUsers.CardTwoNumber,
 			CardOrderId:        vUsers.CardOrderId,
@@ -1309,6 +3102,7 @@ func (uuc *UserUseCase) AdminUserList(ctx context.Context, req *pb.AdminUserList
 			ChangeCardTwo:      vUsers.ChangeCardTwo,
 			LockCard:           vUsers.LockCard,
 			LockCardTwo:        vUsers.LockCardTwo,
+			LockTransfer:       vUsers.LockTransfer,
 			CardNumberRel:      vUsers.CardNumberRel,
 			CardNumberRelTwo:   vUsers.CardNumberRelTwo,
 		})
@@ -1335,7 +3129,12 @@ func (uuc *UserUseCase) AdminUserBind(ctx context.Context, req *pb.AdminUserBind
 		return &pb.AdminUserBindReply{}, err
 	}
 
-	if errThree := uuc.repo.UpdateUserDoing(ctx, user.ID, req.SendBody.CardId, req.SendBody.CarNum, req.SendBody.Amount); errThree != nil {
+	amount, err := money.Parse(req.SendBody.Amount)
+	if nil != err {
+		return &pb.AdminUserBindReply{}, errors.New(500, "AMOUNT_ERROR", "金额格式错误")
+	}
+
+	if errThree := uuc.repo.UpdateUserDoing(ctx, user.ID, req.SendBody.CardId, req.SendBody.CarNum, amount); errThree != nil {
 		fmt.Println("AdminUserBind", "err =", err)
 		// 这条失败就算了，不影响其它
 		return &pb.AdminUserBindReply{}, err
@@ -1367,7 +3166,12 @@ func (uuc *UserUseCase) AdminUserBindTwo(ctx context.Context, req *pb.AdminUserB
 		return &pb.AdminUserBindTwoReply{}, err
 	}
 
-	if errThree := uuc.repo.UpdateCardStatus(ctx, req.SendBody.Id, cardTwo.UserId, req.SendBody.CardId, user.CardNumberRelTwo, req.SendBody.Amount); errThree != nil {
+	amount, err := money.Parse(req.SendBody.Amount)
+	if nil != err {
+		return &pb.AdminUserBindTwoReply{}, errors.New(500, "AMOUNT_ERROR", "金额格式错误")
+	}
+
+	if errThree := uuc.repo.UpdateCardStatus(ctx, req.SendBody.Id, cardTwo.UserId, req.SendBody.CardId, user.CardNumberRelTwo, amount); errThree != nil {
 		fmt.Println("AdminUserBindTwo", "err =", err)
 		// 这条失败就算了，不影响其它
 		return &pb.AdminUserBindTwoReply{}, err
@@ -1546,7 +3350,7 @@ func (uuc *UserUseCase) AdminCardTwoList(ctx context.Context, req *pb.AdminCardT
 func (uuc *UserUseCase) UpdateUserInfoTo(ctx transporthttp.Context) error {
 	var (
 		err       error
-		accountId = interlaceAccountId
+		accountId = uuc.interlace.conf.AccountId
 		bins      []*InterlaceCardBin
 	)
 	// 取原生 *http.Request，后面要用它的 Context 和 FormFile
@@ -1633,55 +3437,51 @@ func (uuc *UserUseCase) UpdateUserInfoTo(ctx transporthttp.Context) error {
 	//}
 
 	// 4. 调用你写好的上传函数（注意：传的是 r.Context()，不是 &context.Context()）
-	fileID, err := InterlaceUploadFile(r.Context(), accountId, fileName, mimeType, fileBytes)
+	fileID, err := uuc.interlace.InterlaceUploadFile(r.Context(), accountId, fileName, mimeType, fileBytes)
 	if err != nil {
 		return err
 	}
 
 	// 4. 调用你写好的上传函数（注意：传的是 r.Context()，不是 &context.Context()）
-	fileIDTwo, err := InterlaceUploadFile(r.Context(), accountId, fileNameTwo, mimeTypeTwo, fileBytesTwo)
+	fileIDTwo, err := uuc.interlace.InterlaceUploadFile(r.Context(), accountId, fileNameTwo, mimeTypeTwo, fileBytesTwo)
 	if err != nil {
 		return err
 	}
 
-	bins, err = InterlaceListAvailableBins(ctx, accountId)
+	bins, err = uuc.interlace.InterlaceListAvailableBins(ctx, accountId)
 	if nil != err {
 		fmt.Println(err)
 		return err
 	}
 
 	for _, v := range bins {
-		// 3. 地址
-		addr := InterlaceAddress{
-			AddressLine1: addressLine1,
-			City:         city,
-			State:        state,
-			Country:      country,
-			PostalCode:   postalCode,
-		}
-
 		// 4. 创建持卡人（只填必需字段）
 		var (
 			cardholderId string
 		)
-		cardholderId, err = InterlaceCreateCardholderMOR(
-			ctx,
-			v.ID,
-			accountId,
-			email,
-			firstName,
-			lastName,
-			dob,
-			gender,
-			nationality,
-			nationalid,
-			idType,
-			addr,
-			fileID,
-			fileIDTwo,
-			phoneNumber,
-			phoneCountryCode,
-		)
+		cardholderId, err = uuc.cardProvider(v.ID, CardProviderInterlace).CreateCardholder(ctx, &CardholderReq{
+			User: &User{
+				Email:            email,
+				FirstName:        firstName,
+				LastName:         lastName,
+				BirthDate:        dob,
+				Gender:           gender,
+				CountryCode:      nationality,
+				IdCard:           nationalid,
+				IdType:           idType,
+				Street:           addressLine1,
+				City:             city,
+				State:            state,
+				Country:          country,
+				PostalCode:       postalCode,
+				Phone:            phoneNumber,
+				PhoneCountryCode: phoneCountryCode,
+			},
+			ProductId:   v.ID,
+			AccountId:   accountId,
+			IdFrontFile: fileID,
+			SelfieFile:  fileIDTwo,
+		})
 		if nil != err {
 			fmt.Println(err, v, cardholderId)
 			continue
@@ -1744,116 +3544,73 @@ func (uuc *UserUseCase) UpdateAllCard(ctx context.Context, req *pb.UpdateAllCard
 			continue
 		}
 
-		var cards []*InterlaceCard
+		// 实体卡目前只有 Interlace
+		provider := uuc.cardProviderByCard(ctx, v.CardId, CardProviderInterlace)
+		resCard, errTwo := provider.GetCard(ctx, v.CardId)
+		if errTwo != nil {
+			fmt.Println("GetCard", "error:", errTwo)
+			// 拉失败就跳过，继续后面的
+			continue
+		}
 
-		cards, _, errTwo := InterlaceListCards(ctx, &InterlaceListCardsReq{
-			AccountId: interlaceAccountId,
-			CardId:    v.CardId,
-			Page:      1,
-			Limit:     10,
-		})
-		if nil == cards || errTwo != nil {
-			fmt.Println("InterlaceListCards page", "error:", errTwo)
-			// 拉失败这一页就跳过，继续后面的
+		if nil == resCard {
 			continue
 		}
 
-		if len(cards) == 0 {
+		ic := resCard.Card
+		// 只保留 ACTIVE
+		if ic.Status != "ACTIVE" {
 			continue
 		}
 
-		for _, ic := range cards {
-			// 只保留 ACTIVE
-			if ic.Status != "ACTIVE" {
-				continue
-			}
+		if ic.CardMode != "PHYSICAL_CARD" {
+			fmt.Println("模式错误", ic, v)
+			continue
+		}
 
-			if ic.CardMode != "PHYSICAL_CARD" {
-				fmt.Println("模式错误", ic, v)
+		if 0 < v.CardAmount.Cmp(money.MustParse("0.01")) {
+			// 划转出去
+			errThree := provider.TransferOut(ctx, v.CardId, money.MustParse(v.CardAmount.StringFixed(2)), "out-two-"+strconv.FormatUint(v.ID, 10))
+			if errThree != nil {
+				fmt.Println("TransferOut error:", v, errThree)
 				continue
 			}
+		}
 
-			if 0.01 < v.CardAmount {
-				// 划转出去
-				data, errThree := InterlaceCardTransferOut(ctx, &InterlaceCardTransferOutReq{
-					AccountId:           interlaceAccountId,
-					CardId:              v.CardId,
-					ClientTransactionId: fmt.Sprintf("out-%d", time.Now().UnixNano()),
-					Amount:              fmt.Sprintf("%.2f", v.CardAmount), // 字符串
-				})
-				if nil == data || errThree != nil {
-					fmt.Println("InterlaceCardTransferOut error:", err)
-					continue
-				}
-
-				if 3 != data.Type {
-					fmt.Println("out err", v, data)
-					continue
-				}
-
-				if "CLOSED" != data.Status {
-					fmt.Println("out status err", v, data)
-				}
-
-				if "FAIL" == data.Status {
-					fmt.Println("out status fail err", v, data)
-					continue
-				}
-			}
-
-			var tmpCreateTime int64
-			tmpCreateTime, err = strconv.ParseInt(ic.CreateTime, 10, 64)
-			if 0 >= tmpCreateTime || nil != err {
-				fmt.Println("InterlaceListCards create time", ic, "error:", err)
-				// 出错就整体结束本次同步，避免老数据乱插
-				break
-			}
+		if 0 >= ic.InterlaceCreateTime {
+			fmt.Println("GetCard create time", ic)
+			// 创建时间不对，跳过这张卡
+			continue
+		}
 
-			// 组装 biz.Card 对象
-			card := &Card{
-				CardID:              ic.ID,
-				AccountID:           ic.AccountID,
-				CardholderID:        ic.CardholderID,
-				BalanceID:           ic.BalanceID,
-				BudgetID:            ic.BudgetID,
-				ReferenceID:         ic.ReferenceID,
-				UserName:            ic.UserName,
-				Currency:            ic.Currency,
-				Bin:                 ic.Bin,
-				Status:              ic.Status,
-				CardMode:            ic.CardMode,
-				Label:               ic.Label,
-				CardLastFour:        ic.CardLastFour,
-				InterlaceCreateTime: tmpCreateTime, // 毫秒时间戳
-			}
+		card := ic
 
-			var (
-				ifHas *Card
-			)
-			ifHas, err = uuc.repo.GetCardByCardId(ctx, card.CardID)
-			if nil != err {
-				continue
-			}
+		var (
+			ifHas *Card
+		)
+		ifHas, err = uuc.repo.GetCardByCardId(ctx, card.CardID)
+		if nil != err {
+			continue
+		}
 
-			if nil != ifHas {
-				if 0 >= ifHas.UserId {
-					if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-						return uuc.repo.CreateCardNew(ctx, v.UserId, v.ID, card, false)
-					}); nil != err {
-						fmt.Println("CreateCard error, cardID =", ic.ID, "err =", err)
-						continue
-					}
-				} else {
-					fmt.Println("已绑定", ic, ifHas)
-					continue
-				}
-			} else {
+		if nil != ifHas {
+			if 0 >= ifHas.UserId {
 				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					return uuc.repo.CreateCardNew(ctx, v.UserId, v.ID, card, true)
+					return uuc.repo.CreateCardNew(ctx, v.UserId, v.ID, card, false)
 				}); nil != err {
-					fmt.Println("CreateCard error, cardID =", ic.ID, "err =", err)
+					fmt.Println("CreateCard error, cardID =", card.CardID, "err =", err)
 					continue
 				}
+			} else {
+				fmt.Println("已绑定", ic, ifHas)
+				continue
+			}
+		} else {
+			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+				return uuc.repo.CreateCardNew(ctx, v.UserId, v.ID, card, true)
+			}); nil != err {
+				fmt.Println("CreateCard error, cardID =", card.CardID, "err =", err)
+				continue
 			}
 		}
 	}
@@ -1862,17 +3619,17 @@ func (uuc *UserUseCase) UpdateAllCard(ctx context.Context, req *pb.UpdateAllCard
 }
 
 func (uuc *UserUseCase) PullAllCard(ctx context.Context, req *pb.PullAllCardRequest) (*pb.PullAllCardReply, error) {
+	// 储备卡所在的渠道
+	provider := uuc.cardProvider(uuc.getCardReserveProduct(), CardProviderInterlace)
 
 	for page := 1; page < 10000; page++ {
-		var cards []*InterlaceCard
-
-		cards, _, errTwo := InterlaceListCards(ctx, &InterlaceListCardsReq{
-			AccountId: interlaceAccountId,
-			Page:      page,
-			Limit:     100,
-		})
+		cards, errTwo := provider.ListCards(ctx, page, 100)
+		if errors.Is(errTwo, ErrCardProviderUnsupported) {
+			fmt.Println("ListCards", provider.Name(), errTwo)
+			break
+		}
 		if nil == cards || errTwo != nil {
-			fmt.Println("InterlaceListCards page", "error:", errTwo)
+			fmt.Println("ListCards page", "error:", errTwo)
 			// 拉失败这一页就跳过，继续后面的
 			continue
 		}
@@ -1881,7 +3638,8 @@ func (uuc *UserUseCase) PullAllCard(ctx context.Context, req *pb.PullAllCardRequ
 			break
 		}
 
-		for _, ic := range cards {
+		for _, v := range cards {
+			ic := v.Card
 			// 只保留 ACTIVE
 			if ic.Status != "ACTIVE" {
 				continue
@@ -1890,31 +3648,13 @@ func (uuc *UserUseCase) PullAllCard(ctx context.Context, req *pb.PullAllCardRequ
 			var (
 				err error
 			)
-			var tmpCreateTime int64
-			tmpCreateTime, err = strconv.ParseInt(ic.CreateTime, 10, 64)
-			if 0 >= tmpCreateTime || nil != err {
-				fmt.Println("InterlaceListCards create time", ic, "error:", err)
+			if 0 >= ic.InterlaceCreateTime {
+				fmt.Println("ListCards create time", ic)
 				// 出错就整体结束本次同步，避免老数据乱插
 				break
 			}
 
-			// 组装 biz.Card 对象
-			card := &Card{
-				CardID:              ic.ID,
-				AccountID:           ic.AccountID,
-				CardholderID:        ic.CardholderID,
-				BalanceID:           ic.BalanceID,
-				BudgetID:            ic.BudgetID,
-				ReferenceID:         ic.ReferenceID,
-				UserName:            ic.UserName,
-				Currency:            ic.Currency,
-				Bin:                 ic.Bin,
-				Status:              ic.Status,
-				CardMode:            ic.CardMode,
-				Label:               ic.Label,
-				CardLastFour:        ic.CardLastFour,
-				InterlaceCreateTime: tmpCreateTime, // 毫秒时间戳
-			}
+			card := ic
 
 			var (
 				ifHas *Card
@@ -1928,7 +3668,7 @@ func (uuc *UserUseCase) PullAllCard(ctx context.Context, req *pb.PullAllCardRequ
 				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
 					return uuc.repo.CreateCardOnly(ctx, card)
 				}); nil != err {
-					fmt.Println("CreateCardOnly error, cardID =", ic.ID, "err =", err)
+					fmt.Println("CreateCardOnly error, cardID =", card.CardID, "err =", err)
 					continue
 				}
 			}
@@ -1946,30 +3686,30 @@ func (uuc *UserUseCase) AutoUpdateAllCard(ctx context.Context, req *pb.UpdateAll
 
 	var (
 		configs       []*Config
-		vipThreeThree float64
-		vipThreeTwo   float64
-		vipThreeOne   float64
-		vipThreeFour  float64
-		vipThreeFive  float64
+		vipThreeThree money.Money
+		vipThreeTwo   money.Money
+		vipThreeOne   money.Money
+		vipThreeFour  money.Money
+		vipThreeFive  money.Money
 	)
 	// 配置
 	configs, err = uuc.repo.GetConfigByKeys("recommend_one", "recommend_two", "recommend_three", "recommend_four", "recommend_five")
 	if nil != configs {
 		for _, vConfig := range configs {
 			if "recommend_three" == vConfig.KeyName {
-				vipThreeThree, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeThree, _ = money.Parse(vConfig.Value)
 			}
 			if "recommend_two" == vConfig.KeyName {
-				vipThreeTwo, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeTwo, _ = money.Parse(vConfig.Value)
 			}
 			if "recommend_one" == vConfig.KeyName {
-				vipThreeOne, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeOne, _ = money.Parse(vConfig.Value)
 			}
 			if "recommend_four" == vConfig.KeyName {
-				vipThreeFour, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeFour, _ = money.Parse(vConfig.Value)
 			}
 			if "recommend_five" == vConfig.KeyName {
-				vipThreeFive, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeFive, _ = money.Parse(vConfig.Value)
 			}
 		}
 	}
@@ -2011,50 +3751,20 @@ func (uuc *UserUseCase) AutoUpdateAllCard(ctx context.Context, req *pb.UpdateAll
 			continue
 		}
 
-		var (
-			res         *InterlaceCardSummaryResp
-			cardAmount  string
-			cardAmountF float64
-		)
-		res, _ = InterlaceGetCardSummary(ctx, interlaceAccountId, card.CardID)
-		if nil != res {
-			cardAmount = res.Data.Balance.Available
-		}
-
-		// 划出余额
-		cardAmountF, _ = strconv.ParseFloat(cardAmount, 10)
-		if 0.001 <= cardAmountF {
-			tmpCaS := strconv.FormatFloat(cardAmountF, 'f', -1, 64)
-			fmt.Println("自动开卡，划转：", cardAmountF, cardAmount, tmpCaS)
+		// 划出余额，查不到按0
+		provider := uuc.cardProvider(card.Bin, CardProviderInterlace)
+		cardAmountF, _ := provider.GetBalance(ctx, card.CardID)
+		if 0 <= cardAmountF.Cmp(money.MustParse("0.001")) {
+			fmt.Println("自动开卡，划转：", cardAmountF)
 
 			// 划转出去
-			data, errThree := InterlaceCardTransferOut(ctx, &InterlaceCardTransferOutReq{
-				AccountId:           interlaceAccountId,
-				CardId:              card.CardID,
-				ClientTransactionId: fmt.Sprintf("out-%d", time.Now().UnixNano()),
-				Amount:              tmpCaS, // 字符串
-			})
-			if nil == data || errThree != nil {
-				fmt.Println("InterlaceCardTransferOut error:", err)
-				continue
-			}
-
-			if 3 != data.Type {
-				fmt.Println("out err", v, data)
-				continue
-			}
-
-			if "CLOSED" != data.Status {
-				fmt.Println("out status err", v, data)
-			}
-
-			if "FAIL" == data.Status {
-				fmt.Println("out status fail err", v, data)
+			if errThree := provider.TransferOut(ctx, card.CardID, cardAmountF, "out-bind-"+strconv.FormatUint(v.ID, 10)+"-"+card.CardID); errThree != nil {
+				fmt.Println("TransferOut error:", v, errThree)
 				continue
 			}
 		}
 
-		fmt.Println("自动开卡，划转：", cardAmountF, cardAmount, v, card, "完成")
+		fmt.Println("自动开卡，划转：", cardAmountF, v, card, "完成")
 		if errFour := uuc.repo.UpdateUserDone(ctx, v.ID, card.CardID, cardAmountF); errFour != nil {
 			fmt.Println("AutoUpdateAllCard", "err =", err)
 			// 这条失败就算了，不影响其它
@@ -2099,23 +3809,28 @@ func (uuc *UserUseCase) AutoUpdateAllCard(ctx context.Context, req *pb.UpdateAll
 					continue
 				}
 
-				tmpAmount := float64(10)
+				tmpAmount := money.New(10)
 				if 1 == tmp {
-					tmpAmount *= vipThreeOne
+					tmpAmount = tmpAmount.Mul(vipThreeOne)
 				} else if 2 == tmp {
-					tmpAmount *= vipThreeTwo
+					tmpAmount = tmpAmount.Mul(vipThreeTwo)
 				} else if 3 == tmp {
-					tmpAmount *= vipThreeThree
+					tmpAmount = tmpAmount.Mul(vipThreeThree)
 				} else if 4 == tmp {
-					tmpAmount *= vipThreeFour
+					tmpAmount = tmpAmount.Mul(vipThreeFour)
 				} else if 5 == tmp {
-					tmpAmount *= vipThreeFive
+					tmpAmount = tmpAmount.Mul(vipThreeFive)
 				} else {
 					break
 				}
 
-				if 0.0001 < tmpAmount {
+				if 0 < tmpAmount.Cmp(money.MustParse("0.0001")) {
 					if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+						err = uuc.LedgerPost(ctx, NewJournal(pb.RewardReason_REWARD_REASON_CARD_RECOMMEND, v.Address).Move(AccountReward, UserAccount(tmpUserId), tmpAmount))
+						if err != nil {
+							return err
+						}
+
 						err = uuc.repo.CreateCardRecommendNew(ctx, tmpUserId, tmpAmount, tmp, v.Address)
 						if err != nil {
 							return err
@@ -2162,7 +3877,12 @@ func (uuc *UserUseCase) AutoUpdateAllCard(ctx context.Context, req *pb.UpdateAll
 				lastVip = usersMap[tmpUserId].Vip
 
 				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					err = uuc.repo.CreateCardRecommend(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, v.Address)
+					err = uuc.LedgerPost(ctx, NewJournal(pb.RewardReason_REWARD_REASON_CARD_RECOMMEND, v.Address).Move(AccountReward, UserAccount(tmpUserId), money.New(int64(tmpAmount))))
+					if err != nil {
+						return err
+					}
+
+					err = uuc.repo.CreateCardRecommend(ctx, tmpUserId, money.New(int64(tmpAmount)), usersMap[tmpUserId].Vip, v.Address)
 					if err != nil {
 						return err
 					}
@@ -2207,30 +3927,30 @@ func (uuc *UserUseCase) UpdateAllCardTwo(ctx context.Context, req *pb.UpdateAllC
 
 	var (
 		configs       []*Config
-		vipThreeThree float64
-		vipThreeTwo   float64
-		vipThreeOne   float64
-		vipThreeFour  float64
-		vipThreeFive  float64
+		vipThreeThree money.Money
+		vipThreeTwo   money.Money
+		vipThreeOne   money.Money
+		vipThreeFour  money.Money
+		vipThreeFive  money.Money
 	)
 	// 配置
 	configs, err = uuc.repo.GetConfigByKeys("recommend_one", "recommend_two", "recommend_three", "recommend_four", "recommend_five")
 	if nil != configs {
 		for _, vConfig := range configs {
 			if "recommend_three" == vConfig.KeyName {
-				vipThreeThree, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeThree, _ = money.Parse(vConfig.Value)
 			}
 			if "recommend_two" == vConfig.KeyName {
-				vipThreeTwo, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeTwo, _ = money.Parse(vConfig.Value)
 			}
 			if "recommend_one" == vConfig.KeyName {
-				vipThreeOne, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeOne, _ = money.Parse(vConfig.Value)
 			}
 			if "recommend_four" == vConfig.KeyName {
-				vipThreeFour, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeFour, _ = money.Parse(vConfig.Value)
 			}
 			if "recommend_five" == vConfig.KeyName {
-				vipThreeFive, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeFive, _ = money.Parse(vConfig.Value)
 			}
 		}
 	}
@@ -2243,227 +3963,192 @@ func (uuc *UserUseCase) UpdateAllCardTwo(ctx context.Context, req *pb.UpdateAllC
 			continue
 		}
 
-		var cards []*InterlaceCard
+		provider := uuc.cardProviderByCard(ctx, v.CardNumber, CardProviderInterlace)
+		resCard, errTwo := provider.GetCard(ctx, v.CardNumber)
+		if errTwo != nil {
+			fmt.Println("GetCard", "error:", errTwo)
+			// 拉失败就跳过，继续后面的
+			continue
+		}
 
-		cards, _, errTwo := InterlaceListCards(ctx, &InterlaceListCardsReq{
-			AccountId: interlaceAccountId,
-			CardId:    v.CardNumber,
-			Page:      1,
-			Limit:     10,
-		})
-		if nil == cards || errTwo != nil {
-			fmt.Println("InterlaceListCards page", "error:", errTwo)
-			// 拉失败这一页就跳过，继续后面的
+		if nil == resCard {
 			continue
 		}
 
-		if len(cards) == 0 {
+		ic := resCard.Card
+		// 只保留 ACTIVE
+		if ic.Status != "ACTIVE" {
 			continue
 		}
 
-		for _, ic := range cards {
-			// 只保留 ACTIVE
-			if ic.Status != "ACTIVE" {
-				continue
-			}
+		if ic.CardMode != "VIRTUAL_CARD" {
+			fmt.Println("模式错误", ic, v)
+			continue
+		}
 
-			if ic.CardMode != "VIRTUAL_CARD" {
-				fmt.Println("模式错误", ic, v)
+		if 0 < v.CardAmount.Cmp(money.MustParse("0.01")) {
+			// 划转出去
+			if errThree := provider.TransferOut(ctx, v.CardNumber, money.MustParse(v.CardAmount.StringFixed(2)), "out-one-"+strconv.FormatUint(v.ID, 10)+"-"+v.CardNumber); errThree != nil {
+				fmt.Println("TransferOut error:", v, errThree)
 				continue
 			}
+		}
 
-			if 0.01 < v.CardAmount {
-				// 划转出去
-				data, errThree := InterlaceCardTransferOut(ctx, &InterlaceCardTransferOutReq{
-					AccountId:           interlaceAccountId,
-					CardId:              v.CardNumber,
-					ClientTransactionId: fmt.Sprintf("out-%d", time.Now().UnixNano()),
-					Amount:              fmt.Sprintf("%.2f", v.CardAmount), // 字符串
-				})
-				if nil == data || errThree != nil {
-					fmt.Println("InterlaceCardTransferOut error:", err)
-					continue
-				}
+		if 0 >= ic.InterlaceCreateTime {
+			fmt.Println("GetCard create time", ic)
+			// 创建时间不对，跳过这张卡
+			continue
+		}
 
-				if 3 != data.Type {
-					fmt.Println("out err", v, data)
-					continue
-				}
+		card := ic
 
-				if "CLOSED" != data.Status {
-					fmt.Println("out status err", v, data)
-				}
+		var (
+			ifHas *Card
+		)
+		ifHas, err = uuc.repo.GetCardByCardId(ctx, card.CardID)
+		if nil != err {
+			continue
+		}
 
-				if "FAIL" == data.Status {
-					fmt.Println("out status fail err", v, data)
+		if nil != ifHas {
+			if 0 >= ifHas.UserId {
+				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+					return uuc.repo.CreateCardOne(ctx, v.ID, card, false)
+				}); nil != err {
+					fmt.Println("CreateCardOne error, cardID =", card.CardID, "err =", err)
 					continue
 				}
+			} else {
+				fmt.Println("已绑定", ic, ifHas)
+				continue
 			}
-
-			var tmpCreateTime int64
-			tmpCreateTime, err = strconv.ParseInt(ic.CreateTime, 10, 64)
-			if 0 >= tmpCreateTime || nil != err {
-				fmt.Println("InterlaceListCards create time", ic, "error:", err)
-				// 出错就整体结束本次同步，避免老数据乱插
-				break
+		} else {
+			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+				return uuc.repo.CreateCardOne(ctx, v.ID, card, true)
+			}); nil != err {
+				fmt.Println("CreateCardOne error, cardID =", card.CardID, "err =", err)
+				continue
 			}
+		}
 
-			// 组装 biz.Card 对象
-			card := &Card{
-				CardID:              ic.ID,
-				AccountID:           ic.AccountID,
-				CardholderID:        ic.CardholderID,
-				BalanceID:           ic.BalanceID,
-				BudgetID:            ic.BudgetID,
-				ReferenceID:         ic.ReferenceID,
-				UserName:            ic.UserName,
-				Currency:            ic.Currency,
-				Bin:                 ic.Bin,
-				Status:              ic.Status,
-				CardMode:            ic.CardMode,
-				Label:               ic.Label,
-				CardLastFour:        ic.CardLastFour,
-				InterlaceCreateTime: tmpCreateTime, // 毫秒时间戳
-			}
+		// 分红
+		var (
+			userRecommend *UserRecommend
+		)
+		tmpRecommendUserIds := make([]string, 0)
+		// 推荐
+		userRecommend, err = uuc.repo.GetUserRecommendByUserId(user.ID)
+		if nil == userRecommend {
+			fmt.Println(err, "信息错误", err, user)
+			continue
+		}
+		if "" != userRecommend.RecommendCode {
+			tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
+		}
 
-			var (
-				ifHas *Card
-			)
-			ifHas, err = uuc.repo.GetCardByCardId(ctx, card.CardID)
-			if nil != err {
-				continue
+		tmpNew := false
+		for _, tmpV := range tmpRecommendUserIds {
+			tmpUserId, _ := strconv.ParseUint(tmpV, 10, 64) // 最后一位是直推人
+			if 1048 == tmpUserId {
+				tmpNew = true
 			}
+		}
 
-			if nil != ifHas {
-				if 0 >= ifHas.UserId {
-					if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-						return uuc.repo.CreateCardOne(ctx, v.ID, card, false)
-					}); nil != err {
-						fmt.Println("CreateCardOne error, cardID =", ic.ID, "err =", err)
-						continue
-					}
-				} else {
-					fmt.Println("已绑定", ic, ifHas)
+		if tmpNew {
+			totalTmp := len(tmpRecommendUserIds) - 1
+			tmp := uint64(0)
+			for i := totalTmp; i >= 0; i-- {
+				tmp++
+				tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
+				if 0 >= tmpUserId {
 					continue
 				}
-			} else {
-				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-					return uuc.repo.CreateCardOne(ctx, v.ID, card, true)
-				}); nil != err {
-					fmt.Println("CreateCardOne error, cardID =", ic.ID, "err =", err)
+
+				if _, ok := usersMap[tmpUserId]; !ok {
+					fmt.Println("开卡遍历，信息缺失：", tmpUserId)
 					continue
 				}
-			}
-
-			// 分红
-			var (
-				userRecommend *UserRecommend
-			)
-			tmpRecommendUserIds := make([]string, 0)
-			// 推荐
-			userRecommend, err = uuc.repo.GetUserRecommendByUserId(user.ID)
-			if nil == userRecommend {
-				fmt.Println(err, "信息错误", err, user)
-				continue
-			}
-			if "" != userRecommend.RecommendCode {
-				tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
-			}
 
-			tmpNew := false
-			for _, tmpV := range tmpRecommendUserIds {
-				tmpUserId, _ := strconv.ParseUint(tmpV, 10, 64) // 最后一位是直推人
-				if 1048 == tmpUserId {
-					tmpNew = true
+				tmpAmount := money.New(10)
+				if 1 == tmp {
+					tmpAmount = tmpAmount.Mul(vipThreeOne)
+				} else if 2 == tmp {
+					tmpAmount = tmpAmount.Mul(vipThreeTwo)
+				} else if 3 == tmp {
+					tmpAmount = tmpAmount.Mul(vipThreeThree)
+				} else if 4 == tmp {
+					tmpAmount = tmpAmount.Mul(vipThreeFour)
+				} else if 5 == tmp {
+					tmpAmount = tmpAmount.Mul(vipThreeFive)
+				} else {
+					break
 				}
-			}
 
-			if tmpNew {
-				totalTmp := len(tmpRecommendUserIds) - 1
-				tmp := uint64(0)
-				for i := totalTmp; i >= 0; i-- {
-					tmp++
-					tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
-					if 0 >= tmpUserId {
-						continue
-					}
+				if 0 < tmpAmount.Cmp(money.MustParse("0.0001")) {
+					if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+						err = uuc.LedgerPost(ctx, NewJournal(pb.RewardReason_REWARD_REASON_CARD_RECOMMEND, user.Address).Move(AccountReward, UserAccount(tmpUserId), tmpAmount))
+						if err != nil {
+							return err
+						}
 
-					if _, ok := usersMap[tmpUserId]; !ok {
-						fmt.Println("开卡遍历，信息缺失：", tmpUserId)
-						continue
-					}
+						err = uuc.repo.CreateCardRecommendNew(ctx, tmpUserId, tmpAmount, tmp, user.Address)
+						if err != nil {
+							return err
+						}
 
-					tmpAmount := float64(10)
-					if 1 == tmp {
-						tmpAmount *= vipThreeOne
-					} else if 2 == tmp {
-						tmpAmount *= vipThreeTwo
-					} else if 3 == tmp {
-						tmpAmount *= vipThreeThree
-					} else if 4 == tmp {
-						tmpAmount *= vipThreeFour
-					} else if 5 == tmp {
-						tmpAmount *= vipThreeFive
-					} else {
-						break
+						return nil
+					}); nil != err {
+						fmt.Println("err reward", err, user, usersMap[tmpUserId])
 					}
+				}
+			}
+		} else {
+			tmpTopVip := uint64(15)
+			totalTmp := len(tmpRecommendUserIds) - 1
+			lastVip := uint64(0)
+			for i := totalTmp; i >= 0; i-- {
+				tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
+				if 0 >= tmpUserId {
+					continue
+				}
 
-					if 0.0001 < tmpAmount {
-						if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-							err = uuc.repo.CreateCardRecommendNew(ctx, tmpUserId, tmpAmount, tmp, user.Address)
-							if err != nil {
-								return err
-							}
+				if _, ok := usersMap[tmpUserId]; !ok {
+					fmt.Println("开卡遍历，信息缺失：", tmpUserId)
+					continue
+				}
 
-							return nil
-						}); nil != err {
-							fmt.Println("err reward", err, user, usersMap[tmpUserId])
-						}
-					}
+				if usersMap[tmpUserId].VipTwo != user.VipTwo {
+					fmt.Println("开卡遍历，信息缺失，不是一个vip区域：", usersMap[tmpUserId], user)
+					continue
 				}
-			} else {
-				tmpTopVip := uint64(15)
-				totalTmp := len(tmpRecommendUserIds) - 1
-				lastVip := uint64(0)
-				for i := totalTmp; i >= 0; i-- {
-					tmpUserId, _ := strconv.ParseUint(tmpRecommendUserIds[i], 10, 64) // 最后一位是直推人
-					if 0 >= tmpUserId {
-						continue
-					}
 
-					if _, ok := usersMap[tmpUserId]; !ok {
-						fmt.Println("开卡遍历，信息缺失：", tmpUserId)
-						continue
-					}
+				if tmpTopVip < usersMap[tmpUserId].Vip {
+					fmt.Println("开卡遍历，vip信息设置错误：", usersMap[tmpUserId], lastVip)
+					break
+				}
 
-					if usersMap[tmpUserId].VipTwo != user.VipTwo {
-						fmt.Println("开卡遍历，信息缺失，不是一个vip区域：", usersMap[tmpUserId], user)
-						continue
-					}
+				// 小于等于上一个级别，跳过
+				if usersMap[tmpUserId].Vip <= lastVip {
+					continue
+				}
 
-					if tmpTopVip < usersMap[tmpUserId].Vip {
-						fmt.Println("开卡遍历，vip信息设置错误：", usersMap[tmpUserId], lastVip)
-						break
-					}
+				tmpAmount := usersMap[tmpUserId].Vip - lastVip // 极差
+				lastVip = usersMap[tmpUserId].Vip
 
-					// 小于等于上一个级别，跳过
-					if usersMap[tmpUserId].Vip <= lastVip {
-						continue
+				if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+					err = uuc.LedgerPost(ctx, NewJournal(pb.RewardReason_REWARD_REASON_CARD_RECOMMEND, user.Address).Move(AccountReward, UserAccount(tmpUserId), money.New(int64(tmpAmount))))
+					if err != nil {
+						return err
 					}
 
-					tmpAmount := usersMap[tmpUserId].Vip - lastVip // 极差
-					lastVip = usersMap[tmpUserId].Vip
-
-					if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-						err = uuc.repo.CreateCardRecommend(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
-						if err != nil {
-							return err
-						}
-
-						return nil
-					}); nil != err {
-						fmt.Println("err reward", err, user, usersMap[tmpUserId])
+					err = uuc.repo.CreateCardRecommend(ctx, tmpUserId, money.New(int64(tmpAmount)), usersMap[tmpUserId].Vip, user.Address)
+					if err != nil {
+						return err
 					}
+
+					return nil
+				}); nil != err {
+					fmt.Println("err reward", err, user, usersMap[tmpUserId])
 				}
 			}
 		}
@@ -2736,13 +4421,11 @@ type CreateCardResponse struct {
 	} `json:"data"`
 }
 
-func CreateCardRequestWithSign(cardAmount uint64, cardholderId uint64, cardProductId uint64) (*CreateCardResponse, error) {
-	//url := "https://test-api.ispay.com/dev-api/vcc/api/v1/cards/create"
-	//url := "https://www.ispay.com/prod-api/vcc/api/v1/cards/create"
-	baseUrl := "http://120.79.173.55:9102/prod-api/vcc/api/v1/cards/create"
+func (p *ISPayProvider) CreateCardRequestWithSign(cardAmount uint64, cardholderId uint64, cardProductId uint64) (*CreateCardResponse, error) {
+	baseUrl := p.conf.BaseURL + "/vcc/api/v1/cards/create"
 
 	reqBody := map[string]interface{}{
-		"merchantId":    "322338",
+		"merchantId":    p.conf.MerchantId,
 		"cardCurrency":  "USD",
 		"cardAmount":    cardAmount,
 		"cardholderId":  cardholderId,
@@ -2757,7 +4440,7 @@ func CreateCardRequestWithSign(cardAmount uint64, cardholderId uint64, cardProdu
 		},
 	}
 
-	sign := GenerateSign(reqBody, "j4gqNRcpTDJr50AP2xd9obKWZIKWbeo9")
+	sign := GenerateSign(reqBody, p.conf.SignKey)
 	// 请求体（包括嵌套结构）
 	reqBody["sign"] = sign
 
@@ -2768,7 +4451,7 @@ func CreateCardRequestWithSign(cardAmount uint64, cardholderId uint64, cardProdu
 
 	//fmt.Println("请求报文:", string(jsonData))
 
-	client := &http.Client{}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -2809,16 +4492,15 @@ type CardInfoResponse struct {
 	} `json:"data"`
 }
 
-func GetCardInfoRequestWithSign(cardId string) (*CardInfoResponse, error) {
-	baseUrl := "http://120.79.173.55:9102/prod-api/vcc/api/v1/cards/info"
-	//baseUrl := "https://www.ispay.com/prod-api/vcc/api/v1/cards/info"
+func (p *ISPayProvider) GetCardInfoRequestWithSign(cardId string) (*CardInfoResponse, error) {
+	baseUrl := p.conf.BaseURL + "/vcc/api/v1/cards/info"
 
 	reqBody := map[string]interface{}{
-		"merchantId": "322338",
+		"merchantId": p.conf.MerchantId,
 		"cardId":     cardId, // 如果需要传 cardId，根据实际接口文档添加
 	}
 
-	sign := GenerateSign(reqBody, "j4gqNRcpTDJr50AP2xd9obKWZIKWbeo9")
+	sign := GenerateSign(reqBody, p.conf.SignKey)
 	reqBody["sign"] = sign
 
 	jsonData, _ := json.Marshal(reqBody)
@@ -2826,7 +4508,7 @@ func GetCardInfoRequestWithSign(cardId string) (*CardInfoResponse, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Content-Language", "zh_CN")
 
-	client := &http.Client{}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -2872,18 +4554,18 @@ type QueryCardHolderResponse struct {
 	Data CardHolderData `json:"data"`
 }
 
-func QueryCardHolderWithSign(holderId uint64, productId uint64) (*QueryCardHolderResponse, error) {
-	baseUrl := "https://www.ispay.com/prod-api/vcc/api/v1/cards/holders/query"
+func (p *ISPayProvider) QueryCardHolderWithSign(holderId uint64, productId uint64) (*QueryCardHolderResponse, error) {
+	baseUrl := p.conf.HolderBaseURL + "/vcc/api/v1/cards/holders/query"
 
 	// 请求体
 	reqBody := map[string]interface{}{
 		"holderId":   holderId,
-		"merchantId": "322338",
+		"merchantId": p.conf.MerchantId,
 		"productId":  productId,
 	}
 
 	// 生成签名
-	sign := GenerateSign(reqBody, "j4gqNRcpTDJr50AP2xd9obKWZIKWbeo9")
+	sign := GenerateSign(reqBody, p.conf.SignKey)
 	reqBody["sign"] = sign
 
 	// 转 JSON
@@ -2898,7 +4580,7 @@ func QueryCardHolderWithSign(holderId uint64, productId uint64) (*QueryCardHolde
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Content-Language", "zh_CN")
 
-	client := &http.Client{}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -2929,14 +4611,6 @@ func QueryCardHolderWithSign(holderId uint64, productId uint64) (*QueryCardHolde
 
 // ================= Interlace 授权配置 & 缓存 =================
 
-const (
-	interlaceBaseURL      = "https://api-sandbox.interlace.money/open-api/v3"
-	interlaceBaseURLV1    = "https://api-sandbox.interlace.money/open-api/v1"
-	interlaceClientID     = "interlacedc0330757f216112"
-	interlaceClientSecret = "c0d8019217ad4903bf09336320a4ddd9" // v3 的接口目前用不到 secret，但建议以后放到配置/环境变量
-	interlaceAccountId    = "cb6c8028-c828-4596-a501-6fa3196af4d7"
-)
-
 // 缓存在当前进程里，如果你将来多实例部署/重启频繁，可以再扩展成 Redis 存储
 type interlaceAuthCache struct {
 	AccessToken  string
@@ -2952,7 +4626,7 @@ var (
 // GetInterlaceAccessToken 获取一个当前可用的 accessToken
 // 1. 如果缓存里有且没过期，直接返回
 // 2. 否则调用 GetCode + Generate Access Token 重新获取
-func GetInterlaceAccessToken(ctx context.Context) (string, error) {
+func (p *InterlaceProvider) GetInterlaceAccessToken(ctx context.Context) (string, error) {
 	interlaceAuthMux.Lock()
 	defer interlaceAuthMux.Unlock()
 
@@ -2964,12 +4638,12 @@ func GetInterlaceAccessToken(ctx context.Context) (string, error) {
 
 	// 这里可以先尝试用 refreshToken 刷新（如果你想用 refresh-token 接口）
 	// 为了简单稳定，这里直接重新 Get Code + Access Token
-	code, err := interlaceGetCode(ctx)
+	code, err := p.interlaceGetCode(ctx)
 	if err != nil {
 		return "", fmt.Errorf("get interlace code failed: %w", err)
 	}
 
-	accessToken, refreshToken, expiresIn, t, err := interlaceGenerateAccessToken(ctx, code)
+	accessToken, refreshToken, expiresIn, t, err := p.interlaceGenerateAccessToken(ctx, code)
 	if 0 >= len(accessToken) || err != nil {
 		return "", fmt.Errorf("generate interlace access token failed: %w", err)
 	}
@@ -2991,8 +4665,8 @@ type interlaceGetCodeResp struct {
 	} `json:"data"`
 }
 
-func interlaceGetCode(ctx context.Context) (string, error) {
-	urlStr := fmt.Sprintf("%s/oauth/authorize?clientId=%s", interlaceBaseURL, interlaceClientID)
+func (p *InterlaceProvider) interlaceGetCode(ctx context.Context) (string, error) {
+	urlStr := fmt.Sprintf("%s/oauth/authorize?clientId=%s", p.conf.BaseURL, p.conf.ClientId)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
 	if err != nil {
@@ -3000,7 +4674,7 @@ func interlaceGetCode(ctx context.Context) (string, error) {
 	}
 	req.Header.Set("Accept", "application/json")
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -3045,11 +4719,11 @@ type interlaceAccessTokenResp struct {
 	} `json:"data"`
 }
 
-func interlaceGenerateAccessToken(ctx context.Context, code string) (accessToken, refreshToken string, expiresIn, t int64, err error) {
-	urlStr := fmt.Sprintf("%s/oauth/access-token", interlaceBaseURL)
+func (p *InterlaceProvider) interlaceGenerateAccessToken(ctx context.Context, code string) (accessToken, refreshToken string, expiresIn, t int64, err error) {
+	urlStr := fmt.Sprintf("%s/oauth/access-token", p.conf.BaseURL)
 
 	reqBody := map[string]interface{}{
-		"clientId": interlaceClientID,
+		"clientId": p.conf.ClientId,
 		"code":     code,
 	}
 	jsonData, _ := json.Marshal(reqBody)
@@ -3061,7 +4735,7 @@ func interlaceGenerateAccessToken(ctx context.Context, code string) (accessToken
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", "", 0, 0, err
@@ -3094,8 +4768,8 @@ func interlaceGenerateAccessToken(ctx context.Context, code string) (accessToken
 	return result.Data.AccessToken, result.Data.RefreshToken, result.Data.ExpiresIn, result.Data.Timestamp, nil
 }
 
-func InterlaceCreateCardholder(ctx context.Context, token string, user *User) (string, error) {
-	urlStr := interlaceBaseURL + "/cardholders"
+func (p *InterlaceProvider) InterlaceCreateCardholder(ctx context.Context, token string, user *User) (string, error) {
+	urlStr := p.conf.BaseURL + "/cardholders"
 
 	reqBody := map[string]interface{}{
 		"programType": "BUSINESS USE - MOR", // 你用的是商户代收付 Mor 模式
@@ -3117,7 +4791,7 @@ func InterlaceCreateCardholder(ctx context.Context, token string, user *User) (s
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+token)
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -3178,14 +4852,14 @@ type InterlaceCardBin struct {
 }
 
 // InterlaceListAvailableBins 使用 x-access-token + accountId 获取可用 BIN
-func InterlaceListAvailableBins(ctx context.Context, accountId string) ([]*InterlaceCardBin, error) {
-	accessToken, err := GetInterlaceAccessToken(ctx)
+func (p *InterlaceProvider) InterlaceListAvailableBins(ctx context.Context, accountId string) ([]*InterlaceCardBin, error) {
+	accessToken, err := p.GetInterlaceAccessToken(ctx)
 	if err != nil || accessToken == "" {
 		fmt.Println("获取access token错误")
 		return nil, err
 	}
 
-	base := interlaceBaseURL + "/card/bins"
+	base := p.conf.BaseURL + "/card/bins"
 	q := url.Values{}
 	q.Set("accountId", accountId)
 	urlStr := base + "?" + q.Encode()
@@ -3197,7 +4871,7 @@ func InterlaceListAvailableBins(ctx context.Context, accountId string) ([]*Inter
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("x-access-token", accessToken)
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -3241,14 +4915,14 @@ func InterlaceListAvailableBins(ctx context.Context, accountId string) ([]*Inter
 
 // InterlaceGetFirstAccountID 调用 v1 /accounts，返回一个可用的 accountId
 // 当前返回示例：{"code":0,"message":"ok","data":{"data":[{...}],"pageTotal":1,"total":1}}
-func InterlaceGetFirstAccountID(ctx context.Context) (string, error) {
-	accessToken, err := GetInterlaceAccessToken(ctx)
+func (p *InterlaceProvider) InterlaceGetFirstAccountID(ctx context.Context) (string, error) {
+	accessToken, err := p.GetInterlaceAccessToken(ctx)
 	if err != nil || accessToken == "" {
 		fmt.Println("获取access token错误", err)
 		return "", err
 	}
 
-	urlStr := interlaceBaseURLV1 + "/accounts"
+	urlStr := p.conf.BaseURLV1 + "/accounts"
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
 	if err != nil {
@@ -3257,7 +4931,7 @@ func InterlaceGetFirstAccountID(ctx context.Context) (string, error) {
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("x-access-token", accessToken)
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -3321,7 +4995,7 @@ func InterlaceGetFirstAccountID(ctx context.Context) (string, error) {
 
 // InterlaceCreateConsumerCardholder
 // 按照 MoR Consumer 模式，只用「必需字段」创建 cardholder，返回 cardholderId。
-func InterlaceCreateConsumerCardholder(ctx context.Context, u *User, bin *InterlaceCardBin) (string, error) {
+func (p *InterlaceProvider) InterlaceCreateConsumerCardholder(ctx context.Context, u *User, bin *InterlaceCardBin) (string, error) {
 	if u == nil {
 		return "", fmt.Errorf("user is nil")
 	}
@@ -3330,7 +5004,7 @@ func InterlaceCreateConsumerCardholder(ctx context.Context, u *User, bin *Interl
 	}
 
 	// 1) 拿 OAuth accessToken（Bearer，用于 /v3/cardholders）
-	accessToken, err := GetInterlaceAccessToken(ctx)
+	accessToken, err := p.GetInterlaceAccessToken(ctx)
 	if err != nil || accessToken == "" {
 		fmt.Println("InterlaceCreateConsumerCardholder: 获取 access token 错误:", err)
 		return "", fmt.Errorf("get access token failed: %w", err)
@@ -3387,7 +5061,7 @@ func InterlaceCreateConsumerCardholder(ctx context.Context, u *User, bin *Interl
 	}
 
 	// 4) 发送 HTTP 请求
-	urlStr := interlaceBaseURL + "/cardholders" // v3
+	urlStr := p.conf.BaseURL + "/cardholders" // v3
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, urlStr, bytes.NewReader(jsonData))
 	if err != nil {
 		return "", err
@@ -3396,7 +5070,7 @@ func InterlaceCreateConsumerCardholder(ctx context.Context, u *User, bin *Interl
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Bearer "+accessToken)
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -3454,7 +5128,7 @@ type InterlaceAddress struct {
 
 // InterlaceCreateCardholderMOR
 // 只用必填字段创建 MoR Consumer 持卡人，返回 cardholderId。
-func InterlaceCreateCardholderMOR(
+func (p *InterlaceProvider) InterlaceCreateCardholderMOR(
 	ctx context.Context,
 	binId string,
 	accountId string,
@@ -3489,7 +5163,7 @@ func InterlaceCreateCardholderMOR(
 		return "", fmt.Errorf("idFrontId/selfie/phoneNumber required")
 	}
 
-	accessToken, err := GetInterlaceAccessToken(ctx)
+	accessToken, err := p.GetInterlaceAccessToken(ctx)
 	if err != nil || accessToken == "" {
 		return "", fmt.Errorf("get access token failed: %w", err)
 	}
@@ -3528,7 +5202,7 @@ func InterlaceCreateCardholderMOR(
 		return "", fmt.Errorf("marshal cardholder body error: %w", err)
 	}
 
-	urlStr := interlaceBaseURL + "/cardholders"
+	urlStr := p.conf.BaseURL + "/cardholders"
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, urlStr, bytes.NewReader(jsonData))
 	if err != nil {
@@ -3539,7 +5213,7 @@ func InterlaceCreateCardholderMOR(
 	// Create cardholder 文档用的是 x-access-token
 	req.Header.Set("x-access-token", accessToken)
 
-	client := &http.Client{Timeout: 15 * time.Second}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -3586,7 +5260,7 @@ func InterlaceCreateCardholderMOR(
 }
 
 // 上传一个文件到 Interlace，返回 fileId（用于 idFrontId / selfie 等）
-func InterlaceUploadFile(ctx context.Context, accountId, fileName, mimeType string, fileData []byte) (string, error) {
+func (p *InterlaceProvider) InterlaceUploadFile(ctx context.Context, accountId, fileName, mimeType string, fileData []byte) (string, error) {
 	if accountId == "" {
 		return "", fmt.Errorf("accountId required")
 	}
@@ -3595,7 +5269,7 @@ func InterlaceUploadFile(ctx context.Context, accountId, fileName, mimeType stri
 	}
 
 	// 1) 拿 accessToken（后面用 x-access-token）
-	accessToken, err := GetInterlaceAccessToken(ctx)
+	accessToken, err := p.GetInterlaceAccessToken(ctx)
 	if err != nil || accessToken == "" {
 		return "", fmt.Errorf("get access token failed: %w", err)
 	}
@@ -3623,7 +5297,7 @@ func InterlaceUploadFile(ctx context.Context, accountId, fileName, mimeType stri
 	}
 
 	// 3) 发请求
-	urlStr := interlaceBaseURL + "/files/upload"
+	urlStr := p.conf.BaseURL + "/files/upload"
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, urlStr, &buf)
 	if err != nil {
@@ -3633,7 +5307,7 @@ func InterlaceUploadFile(ctx context.Context, accountId, fileName, mimeType stri
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 	req.Header.Set("x-access-token", accessToken)
 
-	client := &http.Client{Timeout: 20 * time.Second}
+	client := &http.Client{Timeout: p.conf.UploadTimeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -3741,7 +5415,7 @@ type InterlaceTransactionLimit struct {
 }
 
 // InterlaceListCards 使用 x-access-token + accountId 获取卡片列表
-func InterlaceListCards(ctx context.Context, in *InterlaceListCardsReq) ([]*InterlaceCard, string, error) {
+func (p *InterlaceProvider) InterlaceListCards(ctx context.Context, in *InterlaceListCardsReq) ([]*InterlaceCard, string, error) {
 	if in == nil {
 		return nil, "", fmt.Errorf("list cards req is nil")
 	}
@@ -3749,13 +5423,13 @@ func InterlaceListCards(ctx context.Context, in *InterlaceListCardsReq) ([]*Inte
 		return nil, "", fmt.Errorf("accountId is required")
 	}
 
-	accessToken, err := GetInterlaceAccessToken(ctx)
+	accessToken, err := p.GetInterlaceAccessToken(ctx)
 	if err != nil || accessToken == "" {
 		fmt.Println("获取access token错误")
 		return nil, "", err
 	}
 
-	base := interlaceBaseURL + "/card-list"
+	base := p.conf.BaseURL + "/card-list"
 
 	q := url.Values{}
 	q.Set("accountId", in.AccountId)
@@ -3796,7 +5470,7 @@ func InterlaceListCards(ctx context.Context, in *InterlaceListCardsReq) ([]*Inte
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("x-access-token", accessToken)
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, "", err
@@ -3896,7 +5570,7 @@ type InterlaceCardTransferOutResp struct {
 }
 
 // InterlaceCardTransferOut 预付卡划转出到 Quantum 账户
-func InterlaceCardTransferOut(ctx context.Context, in *InterlaceCardTransferOutReq) (*InterlaceCardTransferOutData, error) {
+func (p *InterlaceProvider) InterlaceCardTransferOut(ctx context.Context, in *InterlaceCardTransferOutReq) (*InterlaceCardTransferOutData, error) {
 	if in == nil {
 		return nil, fmt.Errorf("transfer out req is nil")
 	}
@@ -3913,14 +5587,13 @@ func InterlaceCardTransferOut(ctx context.Context, in *InterlaceCardTransferOutR
 		return nil, fmt.Errorf("amount is required")
 	}
 
-	accessToken, err := GetInterlaceAccessToken(ctx)
+	accessToken, err := p.GetInterlaceAccessToken(ctx)
 	if err != nil || accessToken == "" {
 		fmt.Println("获取access token错误")
 		return nil, err
 	}
-
 	// baseURL 建议为: https://api-sandbox.interlace.money/open-api/v3
-	base := interlaceBaseURL + "/cards/transfer-out"
+	base := p.conf.BaseURL + "/cards/transfer-out"
 
 	bodyBytes, err := json.Marshal(in)
 	if err != nil {
@@ -3935,7 +5608,7 @@ func InterlaceCardTransferOut(ctx context.Context, in *InterlaceCardTransferOutR
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("x-access-token", accessToken)
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -4642,7 +6315,7 @@ type InterlaceCardSummaryResp struct {
 }
 
 // InterlaceGetCardSummary 获取卡片 summary（余额/统计/限额）
-func InterlaceGetCardSummary(ctx context.Context, accountId, cardId string) (*InterlaceCardSummaryResp, error) {
+func (p *InterlaceProvider) InterlaceGetCardSummary(ctx context.Context, accountId, cardId string) (*InterlaceCardSummaryResp, error) {
 	if accountId == "" {
 		return nil, fmt.Errorf("accountId is required")
 	}
@@ -4650,14 +6323,13 @@ func InterlaceGetCardSummary(ctx context.Context, accountId, cardId string) (*In
 		return nil, fmt.Errorf("cardId is required")
 	}
 
-	accessToken, err := GetInterlaceAccessToken(ctx)
+	accessToken, err := p.GetInterlaceAccessToken(ctx)
 	if err != nil || accessToken == "" {
 		fmt.Println("获取access token错误")
 		return nil, err
 	}
 
-	// interlaceBaseURL 建议: https://api-sandbox.interlace.money/open-api/v3
-	base := interlaceBaseURL + "/cards/" + cardId + "/card-summary"
+	base := p.conf.BaseURL + "/cards/" + cardId + "/card-summary"
 
 	q := url.Values{}
 	q.Set("accountId", accountId)
@@ -4670,7 +6342,7 @@ func InterlaceGetCardSummary(ctx context.Context, accountId, cardId string) (*In
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("x-access-token", accessToken)
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := &http.Client{Timeout: p.conf.Timeout}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
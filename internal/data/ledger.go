@@ -0,0 +1,343 @@
+package data
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// LedgerAccount 账户，用户账户的 Balance 为分录合计的缓存，同时缓存在 user.amount。
+// 平台账户每笔记账都会用到，改余额会锁住同一行让记账全部排队，所以不缓存，余额按分录合计。
+// RewardId 为用户开户时 reward 表的最大 id，对账时之后的 reward 才算
+type LedgerAccount struct {
+	ID        uint64      `gorm:"primarykey;type:int"`
+	Code      string      `gorm:"type:varchar(100);not null;uniqueIndex"`
+	UserId    uint64      `gorm:"type:int;not null;default:0"`
+	Balance   money.Money `gorm:"type:decimal(65,20);not null;default:0"`
+	RewardId  uint64      `gorm:"type:int;not null;default:0"`
+	CreatedAt time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time   `gorm:"type:datetime;not null"`
+}
+
+// LedgerJournal 一笔业务
+type LedgerJournal struct {
+	ID        uint64          `gorm:"primarykey;type:int"`
+	Reason    pb.RewardReason `gorm:"type:int;not null"`
+	Ref       string          `gorm:"type:varchar(200);not null;default:''"`
+	Remark    string          `gorm:"type:varchar(200);not null;default:''"`
+	CreatedAt time.Time       `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time       `gorm:"type:datetime;not null"`
+}
+
+// LedgerPosting 分录，同一 journal 合计为0
+type LedgerPosting struct {
+	ID        uint64      `gorm:"primarykey;type:int"`
+	JournalId uint64      `gorm:"type:int;not null;index"`
+	AccountId uint64      `gorm:"type:int;not null;index"`
+	Amount    money.Money `gorm:"type:decimal(65,20);not null"`
+	CreatedAt time.Time   `gorm:"type:datetime;not null"`
+}
+
+// PostJournal 写 journal 和分录，更新账户余额，用户账户余额不足时失败
+func (u *UserRepo) PostJournal(ctx context.Context, j *biz.Journal) error {
+	journal := &LedgerJournal{
+		Reason: j.Reason,
+		Ref:    j.Ref,
+		Remark: j.Remark,
+	}
+	res := u.data.DB(ctx).Table("ledger_journal").Create(journal)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_LEDGER_ERROR", "记账失败")
+	}
+
+	for _, v := range j.Postings {
+		account, err := u.ledgerAccount(ctx, v.Account)
+		if nil != err {
+			return err
+		}
+
+		err = u.ledgerPosting(ctx, journal.ID, account, v.Amount)
+		if nil != err {
+			return err
+		}
+	}
+
+	j.ID = journal.ID
+	j.CreatedAt = journal.CreatedAt
+	return nil
+}
+
+// LedgerRebuildUser 用户余额缓存按分录合计重算，不一致时修正并返回修正前后的值
+func (u *UserRepo) LedgerRebuildUser(ctx context.Context, userId uint64) (*biz.LedgerRebuild, error) {
+	var (
+		total  money.Money
+		before money.Money
+	)
+
+	account, err := u.ledgerAccount(ctx, biz.UserAccount(userId))
+	if nil != err {
+		return nil, err
+	}
+
+	err = u.data.DB(ctx).Table("ledger_posting").Where("account_id=?", account.ID).
+		Select("COALESCE(SUM(amount), 0)").Row().Scan(&total)
+	if nil != err {
+		return nil, errors.New(500, "LEDGER_ERROR", err.Error())
+	}
+
+	err = u.data.DB(ctx).Table("user").Where("id=?", userId).
+		Select("amount").Row().Scan(&before)
+	if nil != err {
+		return nil, errors.New(500, "LEDGER_ERROR", err.Error())
+	}
+
+	if 0 == total.Cmp(before) {
+		return nil, nil
+	}
+
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
+		Updates(map[string]interface{}{
+			"amount":     gorm.Expr("CAST(? AS DECIMAL(65,20))", total),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return nil, errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	res = u.data.DB(ctx).Table("ledger_account").Where("id=?", account.ID).
+		Updates(map[string]interface{}{
+			"balance":    gorm.Expr("CAST(? AS DECIMAL(65,20))", total),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil {
+		return nil, errors.New(500, "UPDATE_LEDGER_ERROR", "账户修改失败")
+	}
+
+	return &biz.LedgerRebuild{
+		UserId: userId,
+		Before: before,
+		After:  total,
+	}, nil
+}
+
+// GetLedgerAccountBalance 账户的分录合计，平台账户的余额只能这样取
+func (u *UserRepo) GetLedgerAccountBalance(ctx context.Context, account string) (money.Money, error) {
+	var total money.Money
+	err := u.data.DB(ctx).Table("ledger_posting").
+		Joins("join ledger_account on ledger_account.id=ledger_posting.account_id").
+		Where("ledger_account.code=?", account).
+		Select("COALESCE(SUM(ledger_posting.amount), 0)").Row().Scan(&total)
+	if nil != err {
+		return money.Zero(), errors.New(500, "LEDGER_ERROR", err.Error())
+	}
+
+	return total, nil
+}
+
+// GetLedgerBalanceBefore 用户账户 before 之前的分录合计
+func (u *UserRepo) GetLedgerBalanceBefore(ctx context.Context, userId uint64, before time.Time) (money.Money, error) {
+	var total money.Money
+	err := u.data.DB(ctx).Table("ledger_posting").
+		Joins("join ledger_account on ledger_account.id=ledger_posting.account_id").
+		Where("ledger_account.code=?", biz.UserAccount(userId)).
+		Where("ledger_posting.created_at<?", before).
+		Select("COALESCE(SUM(ledger_posting.amount), 0)").Row().Scan(&total)
+	if nil != err {
+		return money.Zero(), errors.New(500, "LEDGER_ERROR", err.Error())
+	}
+
+	return total, nil
+}
+
+// GetLedgerStatement 用户账户 [start, end) 内 id 大于 afterId 的分录，按 id 顺序
+func (u *UserRepo) GetLedgerStatement(ctx context.Context, userId uint64, start, end time.Time, afterId uint64, limit int) ([]*biz.StatementLine, error) {
+	var rows []*struct {
+		ID        uint64
+		JournalId uint64
+		Reason    pb.RewardReason
+		Ref       string
+		Remark    string
+		Amount    money.Money
+		CreatedAt time.Time
+	}
+
+	res := make([]*biz.StatementLine, 0)
+	err := u.data.DB(ctx).Table("ledger_posting").
+		Joins("join ledger_account on ledger_account.id=ledger_posting.account_id").
+		Joins("join ledger_journal on ledger_journal.id=ledger_posting.journal_id").
+		Where("ledger_account.code=?", biz.UserAccount(userId)).
+		Where("ledger_posting.created_at>=? and ledger_posting.created_at<?", start, end).
+		Where("ledger_posting.id>?", afterId).
+		Select("ledger_posting.id, ledger_posting.journal_id, ledger_journal.reason, ledger_journal.ref, " +
+			"ledger_journal.remark, ledger_posting.amount, ledger_posting.created_at").
+		Order("ledger_posting.id asc").Limit(limit).Scan(&rows).Error
+	if nil != err {
+		return nil, errors.New(500, "LEDGER_ERROR", err.Error())
+	}
+
+	for _, v := range rows {
+		res = append(res, &biz.StatementLine{
+			ID:        v.ID,
+			JournalId: v.JournalId,
+			Reason:    v.Reason,
+			Ref:       v.Ref,
+			Remark:    v.Remark,
+			Amount:    v.Amount,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// ledgerAccount 查账户，没有就开户，用户第一次开户时把当前余额记为期初
+func (u *UserRepo) ledgerAccount(ctx context.Context, code string) (*LedgerAccount, error) {
+	var account LedgerAccount
+	err := u.data.DB(ctx).Table("ledger_account").Where("code=?", code).First(&account).Error
+	if nil == err {
+		return &account, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New(500, "LEDGER_ERROR", err.Error())
+	}
+
+	account = LedgerAccount{
+		Code:   code,
+		UserId: biz.AccountUserId(code),
+	}
+
+	// reward 都在记账之后写，开户前的已经算在期初余额里
+	if 0 < account.UserId {
+		err = u.data.DB(ctx).Table("reward").Select("COALESCE(MAX(id), 0)").Scan(&account.RewardId).Error
+		if nil != err {
+			return nil, errors.New(500, "LEDGER_ERROR", err.Error())
+		}
+	}
+
+	res := u.data.DB(ctx).Table("ledger_account").Clauses(clause.OnConflict{DoNothing: true}).Create(&account)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_LEDGER_ERROR", "开户失败")
+	}
+
+	// 并发开户时另一个已经建好
+	if 0 >= res.RowsAffected {
+		account = LedgerAccount{}
+		if err = u.data.DB(ctx).Table("ledger_account").Where("code=?", code).First(&account).Error; nil != err {
+			return nil, errors.New(500, "LEDGER_ERROR", err.Error())
+		}
+
+		return &account, nil
+	}
+
+	if 0 >= account.UserId {
+		return &account, nil
+	}
+
+	var opening money.Money
+	err = u.data.DB(ctx).Table("user").Where("id=?", account.UserId).
+		Select("amount").Row().Scan(&opening)
+	if nil != err {
+		return nil, errors.New(500, "LEDGER_ERROR", err.Error())
+	}
+
+	if opening.IsZero() {
+		return &account, nil
+	}
+
+	journal := &LedgerJournal{
+		Reason: pb.RewardReason_REWARD_REASON_UNSPECIFIED,
+		Ref:    code,
+		Remark: "期初余额",
+	}
+	res = u.data.DB(ctx).Table("ledger_journal").Create(journal)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return nil, errors.New(500, "CREATE_LEDGER_ERROR", "记账失败")
+	}
+
+	// user.amount 已经是期初余额，只记分录和账户余额
+	err = u.insertPosting(ctx, journal.ID, account.ID, opening)
+	if nil != err {
+		return nil, err
+	}
+
+	err = u.addAccountBalance(ctx, account.ID, opening)
+	if nil != err {
+		return nil, err
+	}
+
+	platform, err := u.ledgerAccount(ctx, biz.AccountOpening)
+	if nil != err {
+		return nil, err
+	}
+
+	err = u.ledgerPosting(ctx, journal.ID, platform, opening.Neg())
+	if nil != err {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// ledgerPosting 写一条分录，用户账户同时改账户余额和 user.amount，平台账户只写分录
+func (u *UserRepo) ledgerPosting(ctx context.Context, journalId uint64, account *LedgerAccount, amount money.Money) error {
+	err := u.insertPosting(ctx, journalId, account.ID, amount)
+	if nil != err {
+		return err
+	}
+
+	if 0 >= account.UserId {
+		return nil
+	}
+
+	err = u.addAccountBalance(ctx, account.ID, amount)
+	if nil != err {
+		return err
+	}
+
+	instance := u.data.DB(ctx).Table("user").Where("id=?", account.UserId)
+	if 0 > amount.Sign() {
+		instance = instance.Where("amount + CAST(? AS DECIMAL(65,20)) >= 0", amount)
+	}
+
+	res := instance.Updates(map[string]interface{}{
+		"amount":     gorm.Expr("amount + CAST(? AS DECIMAL(65,20))", amount),
+		"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+	})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "余额不足或用户不存在")
+	}
+
+	return nil
+}
+
+func (u *UserRepo) insertPosting(ctx context.Context, journalId, accountId uint64, amount money.Money) error {
+	res := u.data.DB(ctx).Table("ledger_posting").Create(map[string]interface{}{
+		"journal_id": journalId,
+		"account_id": accountId,
+		"amount":     gorm.Expr("CAST(? AS DECIMAL(65,20))", amount),
+		"created_at": time.Now(),
+	})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_LEDGER_ERROR", "记账失败")
+	}
+
+	return nil
+}
+
+func (u *UserRepo) addAccountBalance(ctx context.Context, accountId uint64, amount money.Money) error {
+	res := u.data.DB(ctx).Table("ledger_account").Where("id=?", accountId).
+		Updates(map[string]interface{}{
+			"balance":    gorm.Expr("balance + CAST(? AS DECIMAL(65,20))", amount),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_LEDGER_ERROR", "账户修改失败")
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package data
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"database/sql"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+type BalanceDrift struct {
+	ID        uint64      `gorm:"primarykey;type:int"`
+	RunId     string      `gorm:"type:varchar(20);not null;index"`
+	UserId    uint64      `gorm:"type:int;not null;index"`
+	Stored    money.Money `gorm:"type:decimal(65,20);not null"`
+	Expected  money.Money `gorm:"type:decimal(65,20);not null"`
+	Drift     money.Money `gorm:"type:decimal(65,20);not null"`
+	Detail    string      `gorm:"type:varchar(1000);not null;default:''"`
+	CreatedAt time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time   `gorm:"type:datetime;not null"`
+}
+
+// GetUserBalanceHistory 用户余额和 reward 按原因合计、提现合计、划转合计。
+// 已开记账账户的从开户时算起，之前的余额按期初分录：reward 取开户时记下的 id 之后的，
+// 提现和划转取分录记到这个账户上的，不按时间比，同一秒内开户前后的记录不会重复或漏算。
+// 在一个只读的可重复读事务里查，余额和各项合计来自同一个快照，对账时有新记账也不会误报差异
+func (u *UserRepo) GetUserBalanceHistory(ctx context.Context, userId uint64) (*biz.BalanceHistory, error) {
+	res := &biz.BalanceHistory{
+		Rewards: make(map[pb.RewardReason]money.Money, 0),
+	}
+
+	err := u.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user User
+		err := tx.Table("user").Where("id=?", userId).First(&user).Error
+		if nil != err {
+			return err
+		}
+		res.Stored = user.Amount
+
+		var account LedgerAccount
+		err = tx.Table("ledger_account").Where("code=?", biz.UserAccount(userId)).First(&account).Error
+		if nil != err && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if 0 < account.ID {
+			err = tx.Table("ledger_posting").
+				Joins("join ledger_journal on ledger_journal.id=ledger_posting.journal_id").
+				Where("ledger_posting.account_id=?", account.ID).
+				Where("ledger_journal.reason=?", pb.RewardReason_REWARD_REASON_UNSPECIFIED).
+				Select("COALESCE(SUM(ledger_posting.amount), 0)").Row().Scan(&res.Opening)
+			if nil != err {
+				return err
+			}
+		}
+
+		var rewards []*struct {
+			Reason pb.RewardReason
+			Total  money.Money
+		}
+		err = tx.Table("reward").Where("user_id=? and id>?", userId, account.RewardId).
+			Select("reason, COALESCE(SUM(amount), 0) as total").Group("reason").Scan(&rewards).Error
+		if nil != err {
+			return err
+		}
+		for _, v := range rewards {
+			res.Rewards[v.Reason] = v.Total
+		}
+
+		err = posted(tx.Table("withdraw").Where("user_id=?", userId), "withdraw", pb.RewardReason_REWARD_REASON_WITHDRAW, account.ID).
+			Select("COALESCE(SUM(amount), 0)").Row().Scan(&res.Withdraw)
+		if nil != err {
+			return err
+		}
+
+		err = posted(tx.Table("transfer").Where("user_id=?", userId), "transfer", pb.RewardReason_REWARD_REASON_TRANSFER, account.ID).
+			Select("COALESCE(SUM(amount + fee), 0)").Row().Scan(&res.TransferOut)
+		if nil != err {
+			return err
+		}
+
+		return posted(tx.Table("transfer").Where("to_user_id=?", userId), "transfer", pb.RewardReason_REWARD_REASON_TRANSFER, account.ID).
+			Select("COALESCE(SUM(amount), 0)").Row().Scan(&res.TransferIn)
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if nil != err {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "RECONCILE_ERROR", err.Error())
+	}
+
+	return res, nil
+}
+
+// posted 只留分录记到 accountId 上的记录，分录 ref 为 "<table>:<id>"，没开户的全部保留
+func posted(instance *gorm.DB, table string, reason pb.RewardReason, accountId uint64) *gorm.DB {
+	if 0 >= accountId {
+		return instance
+	}
+
+	return instance.Where("exists (select 1 from ledger_journal join ledger_posting on ledger_posting.journal_id=ledger_journal.id "+
+		"where ledger_journal.ref=CONCAT(?, "+table+".id) and ledger_journal.reason=? and ledger_posting.account_id=?)", table+":", reason, accountId)
+}
+
+// CreateBalanceDrift .
+func (u *UserRepo) CreateBalanceDrift(ctx context.Context, d *biz.BalanceDrift) error {
+	drift := &BalanceDrift{
+		RunId:    d.RunId,
+		UserId:   d.UserId,
+		Stored:   d.Stored,
+		Expected: d.Expected,
+		Drift:    d.Drift,
+		Detail:   d.Detail,
+	}
+	res := u.data.DB(ctx).Table("balance_drift").Create(drift)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_DRIFT_ERROR", "对账差异记录创建失败")
+	}
+
+	d.ID = drift.ID
+	d.CreatedAt = drift.CreatedAt
+	return nil
+}
+
+// GetLastBalanceDriftRunId 最近一次有差异的对账批次，没有返回空
+func (u *UserRepo) GetLastBalanceDriftRunId() (string, error) {
+	var drift BalanceDrift
+	if err := u.data.db.Table("balance_drift").Order("id desc").First(&drift).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+
+		return "", errors.New(500, "DRIFT ERROR", err.Error())
+	}
+
+	return drift.RunId, nil
+}
+
+// GetBalanceDriftPage .
+func (u *UserRepo) GetBalanceDriftPage(b *biz.Pagination, runId string, userId uint64) ([]*biz.BalanceDrift, error, int64) {
+	var (
+		count  int64
+		drifts []*BalanceDrift
+	)
+
+	res := make([]*biz.BalanceDrift, 0)
+
+	instance := u.data.db.Table("balance_drift").Where("run_id=?", runId).Order("id asc")
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+
+	instance = instance.Count(&count)
+
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Find(&drifts).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "DRIFT ERROR", err.Error()), 0
+	}
+
+	for _, v := range drifts {
+		res = append(res, &biz.BalanceDrift{
+			ID:        v.ID,
+			RunId:     v.RunId,
+			UserId:    v.UserId,
+			Stored:    v.Stored,
+			Expected:  v.Expected,
+			Drift:     v.Drift,
+			Detail:    v.Detail,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
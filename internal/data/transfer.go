@@ -0,0 +1,139 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type Transfer struct {
+	ID        uint64      `gorm:"primarykey;type:int"`
+	UserId    uint64      `gorm:"type:int;not null;uniqueIndex:idx_user_idem"`
+	ToUserId  uint64      `gorm:"type:int;not null;index"`
+	Amount    money.Money `gorm:"type:decimal(65,20);not null"`
+	Fee       money.Money `gorm:"type:decimal(65,20);not null;default:0"`
+	IdemKey   string      `gorm:"type:varchar(100);not null;uniqueIndex:idx_user_idem"`
+	CreatedAt time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time   `gorm:"type:datetime;not null"`
+}
+
+// CreateTransfer 写划转记录，同一用户的 idem_key 已存在时返回 TRANSFER_DUPLICATE
+func (u *UserRepo) CreateTransfer(ctx context.Context, t *biz.Transfer) error {
+	transfer := &Transfer{
+		UserId:   t.UserId,
+		ToUserId: t.ToUserId,
+		Amount:   t.Amount,
+		Fee:      t.Fee,
+		IdemKey:  t.IdemKey,
+	}
+	res := u.data.DB(ctx).Table("transfer").Clauses(clause.OnConflict{DoNothing: true}).Create(transfer)
+	if res.Error != nil {
+		return errors.New(500, "CREATE_TRANSFER_ERROR", "划转记录创建失败")
+	}
+
+	if 0 >= res.RowsAffected {
+		return errors.New(500, "TRANSFER_DUPLICATE", "重复提交")
+	}
+
+	t.ID = transfer.ID
+	t.CreatedAt = transfer.CreatedAt
+	return nil
+}
+
+// GetTransferByIdemKey .
+func (u *UserRepo) GetTransferByIdemKey(userId uint64, idemKey string) (*biz.Transfer, error) {
+	var transfer Transfer
+	if err := u.data.db.Table("transfer").Where("user_id=? and idem_key=?", userId, idemKey).First(&transfer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "TRANSFER ERROR", err.Error())
+	}
+
+	return toBizTransfer(&transfer), nil
+}
+
+// LockTransferUser 锁住转出用户，同一用户的划转在事务内排队，限额按锁住后的数据判断，需在事务内调用
+func (u *UserRepo) LockTransferUser(ctx context.Context, userId uint64) (*biz.User, error) {
+	var user User
+	if err := u.data.DB(ctx).Table("user").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id=?", userId).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "USER ERROR", err.Error())
+	}
+
+	return &biz.User{
+		ID:           user.ID,
+		Address:      user.Address,
+		Amount:       user.Amount,
+		IsDelete:     user.IsDelete,
+		LockTransfer: user.LockTransfer,
+	}, nil
+}
+
+// GetTransferDayTotal 用户 start 之后转出的金额和次数，不含手续费
+func (u *UserRepo) GetTransferDayTotal(ctx context.Context, userId uint64, start time.Time) (money.Money, int64, error) {
+	var (
+		total money.Money
+		count int64
+	)
+
+	err := u.data.DB(ctx).Table("transfer").Where("user_id=? and created_at>=?", userId, start).
+		Select("COALESCE(SUM(amount), 0), COUNT(*)").Row().Scan(&total, &count)
+	if nil != err {
+		return money.Zero(), 0, errors.New(500, "TRANSFER ERROR", err.Error())
+	}
+
+	return total, count, nil
+}
+
+// GetTransferPage userId 为转出或转入方
+func (u *UserRepo) GetTransferPage(b *biz.Pagination, userId uint64) ([]*biz.Transfer, error, int64) {
+	var (
+		count     int64
+		transfers []*Transfer
+	)
+
+	res := make([]*biz.Transfer, 0)
+
+	instance := u.data.db.Table("transfer").Order("id desc")
+	if 0 < userId {
+		instance = instance.Where("user_id=? or to_user_id=?", userId, userId)
+	}
+
+	instance = instance.Count(&count)
+
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Find(&transfers).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "TRANSFER ERROR", err.Error()), 0
+	}
+
+	for _, v := range transfers {
+		res = append(res, toBizTransfer(v))
+	}
+
+	return res, nil, count
+}
+
+func toBizTransfer(t *Transfer) *biz.Transfer {
+	return &biz.Transfer{
+		ID:        t.ID,
+		UserId:    t.UserId,
+		ToUserId:  t.ToUserId,
+		Amount:    t.Amount,
+		Fee:       t.Fee,
+		IdemKey:   t.IdemKey,
+		CreatedAt: t.CreatedAt,
+	}
+}
@@ -1,106 +1,111 @@
 package data
 
 import (
+	pb "cardbinance/api/user/v1"
 	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/money"
 	"context"
+	"encoding/json"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type User struct {
-	ID               uint64    `gorm:"primarykey;type:int"`
-	Address          string    `gorm:"type:varchar(100);default:'no'"`
-	Card             string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardOrderId      string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardNumber       string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardTwoNumber    string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardAmount       float64   `gorm:"type:decimal(65,20);not null"`
-	Amount           float64   `gorm:"type:decimal(65,20)"`
-	IsDelete         uint64    `gorm:"type:int"`
-	Vip              uint64    `gorm:"type:int"`
-	MyTotalAmount    float64   `gorm:"type:decimal(65,20);not null"`
-	AmountTwo        uint64    `gorm:"type:bigint"`
-	CardUserId       string    `gorm:"type:varchar(45);not null;default:'0'"`
-	FirstName        string    `gorm:"type:varchar(45);not null;default:'no'"`
-	LastName         string    `gorm:"type:varchar(45);not null;default:'no'"`
-	BirthDate        string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Email            string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CountryCode      string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Phone            string    `gorm:"type:varchar(45);not null;default:'no'"`
-	City             string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Country          string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Street           string    `gorm:"type:varchar(100);not null;default:'no'"`
-	PostalCode       string    `gorm:"type:varchar(45);not null;default:'no'"`
-	MaxCardQuota     uint64    `gorm:"type:bigint"`
-	ProductId        string    `gorm:"type:varchar(45);not null;default:'0'"`
-	CreatedAt        time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
-	VipTwo           uint64    `gorm:"type:int"`
-	VipThree         uint64    `gorm:"type:int"`
-	CardTwo          uint64    `gorm:"type:int"`
-	CanVip           uint64    `gorm:"type:int"`
-	UserCount        uint64    `gorm:"type:int"`
-	LockCard         uint64    `gorm:"type:int"`
-	LockCardTwo      uint64    `gorm:"type:int"`
-	ChangeCard       uint64    `gorm:"type:int"`
-	ChangeCardTwo    uint64    `gorm:"type:int"`
-	Pic              string    `gorm:"type:varchar(45);not null;default:'no'"`
-	PicTwo           string    `gorm:"type:varchar(45);not null;default:'no'"`
-	CardNumberRel    string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardNumberRelTwo string    `gorm:"type:varchar(100);not null;default:'no'"`
+	ID               uint64      `gorm:"primarykey;type:int"`
+	Address          string      `gorm:"type:varchar(100);default:'no'"`
+	Card             string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardOrderId      string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardNumber       string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardTwoNumber    string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardAmount       money.Money `gorm:"type:decimal(65,20);not null"`
+	Amount           money.Money `gorm:"type:decimal(65,20)"`
+	IsDelete         uint64      `gorm:"type:int"`
+	Vip              uint64      `gorm:"type:int"`
+	MyTotalAmount    money.Money `gorm:"type:decimal(65,20);not null"`
+	AmountTwo        uint64      `gorm:"type:bigint"`
+	CardUserId       string      `gorm:"type:varchar(45);not null;default:'0'"`
+	FirstName        string      `gorm:"type:varchar(45);not null;default:'no'"`
+	LastName         string      `gorm:"type:varchar(45);not null;default:'no'"`
+	BirthDate        string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Email            string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CountryCode      string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Phone            string      `gorm:"type:varchar(45);not null;default:'no'"`
+	City             string      `gorm:"type:varchar(100);not null;default:'no'"`
+	Country          string      `gorm:"type:varchar(100);not null;default:'no'"`
+	Street           string      `gorm:"type:varchar(100);not null;default:'no'"`
+	PostalCode       string      `gorm:"type:varchar(45);not null;default:'no'"`
+	MaxCardQuota     uint64      `gorm:"type:bigint"`
+	ProductId        string      `gorm:"type:varchar(45);not null;default:'0'"`
+	CreatedAt        time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt        time.Time   `gorm:"type:datetime;not null"`
+	VipTwo           uint64      `gorm:"type:int"`
+	VipThree         uint64      `gorm:"type:int"`
+	CardTwo          uint64      `gorm:"type:int"`
+	CanVip           uint64      `gorm:"type:int"`
+	UserCount        uint64      `gorm:"type:int"`
+	LockCard         uint64      `gorm:"type:int"`
+	LockCardTwo      uint64      `gorm:"type:int"`
+	LockTransfer     uint64      `gorm:"type:int;not null;default:0"`
+	ChangeCard       uint64      `gorm:"type:int"`
+	ChangeCardTwo    uint64      `gorm:"type:int"`
+	Pic              string      `gorm:"type:varchar(45);not null;default:'no'"`
+	PicTwo           string      `gorm:"type:varchar(45);not null;default:'no'"`
+	CardNumberRel    string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardNumberRelTwo string      `gorm:"type:varchar(100);not null;default:'no'"`
 }
 
 type CardTwo struct {
-	ID               uint64    `gorm:"primarykey;type:int"`
-	UserId           uint64    `gorm:"type:int;not null"`
-	FirstName        string    `gorm:"type:varchar(45);not null;default:'no'"`
-	LastName         string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Email            string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CountryCode      string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Phone            string    `gorm:"type:varchar(45);not null;default:'no'"`
-	City             string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Country          string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Street           string    `gorm:"type:varchar(100);not null;default:'no'"`
-	PostalCode       string    `gorm:"type:varchar(45);not null;default:'no'"`
-	BirthDate        string    `gorm:"type:varchar(45);not null;default:'no'"`
-	PhoneCountryCode string    `gorm:"type:varchar(45);not null;default:'no'"`
-	State            string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Status           uint64    `gorm:"type:int"`
-	CardId           string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardAmount       float64   `gorm:"type:decimal(65,20);not null"`
-	CreatedAt        time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
-	IdCard           string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Gender           string    `gorm:"type:varchar(45);not null;default:'no'"`
+	ID               uint64      `gorm:"primarykey;type:int"`
+	UserId           uint64      `gorm:"type:int;not null"`
+	FirstName        string      `gorm:"type:varchar(45);not null;default:'no'"`
+	LastName         string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Email            string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CountryCode      string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Phone            string      `gorm:"type:varchar(45);not null;default:'no'"`
+	City             string      `gorm:"type:varchar(100);not null;default:'no'"`
+	Country          string      `gorm:"type:varchar(100);not null;default:'no'"`
+	Street           string      `gorm:"type:varchar(100);not null;default:'no'"`
+	PostalCode       string      `gorm:"type:varchar(45);not null;default:'no'"`
+	BirthDate        string      `gorm:"type:varchar(45);not null;default:'no'"`
+	PhoneCountryCode string      `gorm:"type:varchar(45);not null;default:'no'"`
+	State            string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Status           uint64      `gorm:"type:int"`
+	CardId           string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardAmount       money.Money `gorm:"type:decimal(65,20);not null"`
+	CreatedAt        time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt        time.Time   `gorm:"type:datetime;not null"`
+	IdCard           string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Gender           string      `gorm:"type:varchar(45);not null;default:'no'"`
 }
 
 type CardTwoNew struct {
-	ID               uint64    `gorm:"primarykey;type:int"`
-	UserId           uint64    `gorm:"type:int;not null"`
-	FirstName        string    `gorm:"type:varchar(45);not null;default:'no'"`
-	LastName         string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Email            string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CountryCode      string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Phone            string    `gorm:"type:varchar(45);not null;default:'no'"`
-	City             string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Country          string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Street           string    `gorm:"type:varchar(100);not null;default:'no'"`
-	PostalCode       string    `gorm:"type:varchar(45);not null;default:'no'"`
-	BirthDate        string    `gorm:"type:varchar(45);not null;default:'no'"`
-	PhoneCountryCode string    `gorm:"type:varchar(45);not null;default:'no'"`
-	State            string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Status           uint64    `gorm:"type:int"`
-	Num              uint64    `gorm:"type:int"`
-	CardId           string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardAmount       float64   `gorm:"type:decimal(65,20);not null"`
-	CreatedAt        time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
-	IdCard           string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Gender           string    `gorm:"type:varchar(45);not null;default:'no'"`
+	ID               uint64      `gorm:"primarykey;type:int"`
+	UserId           uint64      `gorm:"type:int;not null"`
+	FirstName        string      `gorm:"type:varchar(45);not null;default:'no'"`
+	LastName         string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Email            string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CountryCode      string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Phone            string      `gorm:"type:varchar(45);not null;default:'no'"`
+	City             string      `gorm:"type:varchar(100);not null;default:'no'"`
+	Country          string      `gorm:"type:varchar(100);not null;default:'no'"`
+	Street           string      `gorm:"type:varchar(100);not null;default:'no'"`
+	PostalCode       string      `gorm:"type:varchar(45);not null;default:'no'"`
+	BirthDate        string      `gorm:"type:varchar(45);not null;default:'no'"`
+	PhoneCountryCode string      `gorm:"type:varchar(45);not null;default:'no'"`
+	State            string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Status           uint64      `gorm:"type:int"`
+	Num              uint64      `gorm:"type:int"`
+	CardId           string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardAmount       money.Money `gorm:"type:decimal(65,20);not null"`
+	CreatedAt        time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt        time.Time   `gorm:"type:datetime;not null"`
+	IdCard           string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Gender           string      `gorm:"type:varchar(45);not null;default:'no'"`
 }
 
 type Admin struct {
@@ -128,14 +133,14 @@ type Config struct {
 }
 
 type Reward struct {
-	ID        uint64    `gorm:"primarykey;type:int"`
-	UserId    uint64    `gorm:"type:int;not null"`
-	Amount    float64   `gorm:"type:decimal(65,20);not null"`
-	Reason    uint64    `gorm:"type:int;not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt time.Time `gorm:"type:datetime;not null"`
-	Address   string    `gorm:"type:varchar(100);not null"`
-	One       uint64    `gorm:"type:int;not null"`
+	ID        uint64          `gorm:"primarykey;type:int"`
+	UserId    uint64          `gorm:"type:int;not null"`
+	Amount    money.Money     `gorm:"type:decimal(65,20);not null"`
+	Reason    pb.RewardReason `gorm:"type:int;not null"`
+	CreatedAt time.Time       `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time       `gorm:"type:datetime;not null"`
+	Address   string          `gorm:"type:varchar(100);not null"`
+	One       uint64          `gorm:"type:int;not null"`
 }
 
 type CardRecord struct {
@@ -150,25 +155,84 @@ type CardRecord struct {
 }
 
 type Withdraw struct {
-	ID        uint64    `gorm:"primarykey;type:int"`
-	UserId    uint64    `gorm:"type:int"`
-	Amount    float64   `gorm:"type:decimal(65,20);not null"`
-	RelAmount float64   `gorm:"type:decimal(65,20);not null"`
-	Status    string    `gorm:"type:varchar(45);not null"`
-	Address   string    `gorm:"type:varchar(45);not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+	ID          uint64      `gorm:"primarykey;type:int"`
+	UserId      uint64      `gorm:"type:int"`
+	Amount      money.Money `gorm:"type:decimal(65,20);not null"`
+	RelAmount   money.Money `gorm:"type:decimal(65,20);not null"`
+	Status      string      `gorm:"type:varchar(45);not null"`
+	Address     string      `gorm:"type:varchar(45);not null"`
+	TxHash      string      `gorm:"type:varchar(100);not null;default:''"`
+	TxHistory   string      `gorm:"type:text"`
+	Nonce       uint64      `gorm:"type:bigint;not null;default:0"`
+	GasPrice    string      `gorm:"type:varchar(100);not null;default:''"`
+	GasLimit    uint64      `gorm:"type:bigint;not null;default:0"`
+	Rpc         string      `gorm:"type:varchar(200);not null;default:''"`
+	Attempts    uint64      `gorm:"type:int;not null;default:0"`
+	RawTx       string      `gorm:"type:text"`
+	Remark      string      `gorm:"type:varchar(200);not null;default:''"`
+	Fee         money.Money `gorm:"type:decimal(65,20);not null;default:0"`
+	Dust        string      `gorm:"type:varchar(45);not null;default:''"`
+	CarryId     uint64      `gorm:"type:int;not null;default:0"`
+	GasUsed     uint64      `gorm:"type:bigint;not null;default:0"`
+	GasCost     string      `gorm:"type:varchar(100);not null;default:''"`
+	BroadcastAt *time.Time  `gorm:"type:datetime"`
+	CreatedAt   time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time   `gorm:"type:datetime;not null"`
 }
 
 type EthUserRecord struct {
-	ID        int64     `gorm:"primarykey;type:int"`
-	Hash      string    `gorm:"type:varchar(100);not null"`
-	UserId    int64     `gorm:"type:int;not null"`
-	Amount    string    `gorm:"type:varchar(45);not null"`
-	AmountTwo uint64    `gorm:"type:int;not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt time.Time `gorm:"type:datetime;not null"`
-	Last      int64     `gorm:"type:int;not null"`
+	ID           int64     `gorm:"primarykey;type:int"`
+	Hash         string    `gorm:"type:varchar(100);not null"`
+	UserId       int64     `gorm:"type:int;not null"`
+	Amount       string    `gorm:"type:varchar(100);not null"`
+	AmountTwo    uint64    `gorm:"type:int;not null"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+	Last         int64     `gorm:"type:int;not null"`
+	BlockNumber  uint64    `gorm:"type:bigint;not null;default:0"`
+	ChainId      int64     `gorm:"type:bigint;not null;default:0;uniqueIndex:uniq_chain_contract_index"`
+	Contract     string    `gorm:"type:varchar(100);not null;default:'';uniqueIndex:uniq_chain_contract_index"`
+	DepositIndex *int64    `gorm:"type:bigint;uniqueIndex:uniq_chain_contract_index"` // 旧记录为空
+	Uncredited   uint64    `gorm:"type:int;not null;default:0"`                       // 1 记录时未加余额，旧记录都已入账
+	Decimals     uint32    `gorm:"type:int;not null;default:0"`                       // Amount 的小数位，旧记录为0
+}
+
+type EthBlockCursor struct {
+	ID          uint64    `gorm:"primarykey;type:int"`
+	ChainId     int64     `gorm:"type:bigint;not null;default:0;uniqueIndex:uniq_chain_contract"`
+	Contract    string    `gorm:"type:varchar(100);not null;uniqueIndex:uniq_chain_contract"`
+	BlockNumber uint64    `gorm:"type:bigint;not null"`
+	BlockHash   string    `gorm:"type:varchar(100);not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
+}
+
+// FeeRecord 平台手续费账户流水
+type FeeRecord struct {
+	ID         uint64      `gorm:"primarykey;type:int"`
+	Account    string      `gorm:"type:varchar(45);not null;index"`
+	UserId     uint64      `gorm:"type:int;not null"`
+	WithdrawId uint64      `gorm:"type:int;not null"`
+	Amount     money.Money `gorm:"type:decimal(65,20);not null"`
+	CreatedAt  time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time   `gorm:"type:datetime;not null"`
+}
+
+type EthDepositPending struct {
+	ID           int64     `gorm:"primarykey;type:int"`
+	UserId       int64     `gorm:"type:int;not null"`
+	Address      string    `gorm:"type:varchar(100);not null"`
+	Hash         string    `gorm:"type:varchar(100);not null"`
+	Amount       string    `gorm:"type:varchar(100);not null"`
+	Decimals     uint32    `gorm:"type:int;not null"`
+	BlockNumber  uint64    `gorm:"type:bigint;not null"`
+	ChainId      int64     `gorm:"type:bigint;not null;uniqueIndex:uniq_chain_contract_index"`
+	Contract     string    `gorm:"type:varchar(100);not null;uniqueIndex:uniq_chain_contract_index"`
+	DepositIndex int64     `gorm:"type:bigint;not null;uniqueIndex:uniq_chain_contract_index"`
+	Status       string    `gorm:"type:varchar(45);not null"`
+	Remark       string    `gorm:"type:varchar(200);not null;default:''"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
 }
 
 type CardOrder struct {
@@ -244,6 +308,85 @@ func (u *UserRepo) SetNonceByAddress(ctx context.Context, wallet string) (int64,
 	return t, nil
 }
 
+// 优先取放回的最小 nonce，否则取计数器，计数器不低于链上 pending nonce
+var allocateNonceScript = redis.NewScript(`
+local pending = tonumber(ARGV[1])
+redis.call("zremrangebyscore", KEYS[2], "-inf", "(" .. pending)
+local free = redis.call("zrange", KEYS[2], 0, 0)
+if #free > 0 then
+	redis.call("zrem", KEYS[2], free[1])
+	return tonumber(free[1])
+end
+local n = tonumber(redis.call("get", KEYS[1]) or "0")
+if n < pending then
+	n = pending
+end
+redis.call("set", KEYS[1], n + 1)
+return n
+`)
+
+func nonceKeys(address string) []string {
+	address = strings.ToLower(address)
+	return []string{"nonce:" + address, "nonce_free:" + address}
+}
+
+// AllocateNonce .
+func (u *UserRepo) AllocateNonce(ctx context.Context, address string, pending uint64) (uint64, error) {
+	res, err := allocateNonceScript.Run(ctx, u.data.rdb, nonceKeys(address), pending).Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(res), nil
+}
+
+// ReleaseNonce .
+func (u *UserRepo) ReleaseNonce(ctx context.Context, address string, nonce uint64) error {
+	return u.data.rdb.ZAdd(ctx, nonceKeys(address)[1], &redis.Z{Score: float64(nonce), Member: nonce}).Err()
+}
+
+// ResetNonce 计数器和放回的 nonce 一起重置
+func (u *UserRepo) ResetNonce(ctx context.Context, address string, next uint64, free []uint64) error {
+	keys := nonceKeys(address)
+	_, err := u.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, keys[0], next, 0)
+		pipe.Del(ctx, keys[1])
+		for _, v := range free {
+			pipe.ZAdd(ctx, keys[1], &redis.Z{Score: float64(v), Member: v})
+		}
+		return nil
+	})
+
+	return err
+}
+
+// GetWithdrawPause .
+func (u *UserRepo) GetWithdrawPause(ctx context.Context) (*biz.WithdrawPause, error) {
+	val, err := u.data.rdb.Get(ctx, "withdraw_pause").Result()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var res biz.WithdrawPause
+	if err = json.Unmarshal([]byte(val), &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// SetWithdrawPause .
+func (u *UserRepo) SetWithdrawPause(ctx context.Context, pause *biz.WithdrawPause) error {
+	val, err := json.Marshal(pause)
+	if err != nil {
+		return err
+	}
+
+	return u.data.rdb.Set(ctx, "withdraw_pause", val, 0).Err()
+}
+
 // GetAndDeleteWalletTimestamp 获取并删除，确保只用一次（无并发可用）
 func (u *UserRepo) GetAndDeleteWalletTimestamp(ctx context.Context, wallet string) (string, error) {
 	key := "wallet:" + wallet
@@ -287,6 +430,7 @@ func (u *UserRepo) GetUserByAddress(address string) (*biz.User, error) {
 		CardOrderId:   user.CardOrderId,
 		CreatedAt:     user.CreatedAt,
 		UpdatedAt:     user.UpdatedAt,
+		LockTransfer:  user.LockTransfer,
 	}, nil
 }
 
@@ -369,6 +513,7 @@ func (u *UserRepo) GetUserById(userId uint64) (*biz.User, error) {
 		CreatedAt:        user.CreatedAt,
 		UpdatedAt:        user.UpdatedAt,
 		CardNumberRelTwo: user.CardNumberRelTwo,
+		LockTransfer:     user.LockTransfer,
 	}, nil
 }
 
@@ -523,6 +668,274 @@ func (u *UserRepo) UpdateWithdraw(ctx context.Context, id uint64, status string)
 	}, nil
 }
 
+// UpdateWithdrawTx 保存提现交易信息
+func (u *UserRepo) UpdateWithdrawTx(ctx context.Context, w *biz.Withdraw) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", w.ID).
+		Updates(map[string]interface{}{
+			"status":       w.Status,
+			"tx_hash":      w.TxHash,
+			"tx_history":   w.TxHistory,
+			"nonce":        w.Nonce,
+			"gas_price":    w.GasPrice,
+			"gas_limit":    w.GasLimit,
+			"rpc":          w.Rpc,
+			"attempts":     w.Attempts,
+			"raw_tx":       w.RawTx,
+			"broadcast_at": w.BroadcastAt,
+			"updated_at":   time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawStatus 状态从 from 改为 to
+func (u *UserRepo) UpdateWithdrawStatus(ctx context.Context, id uint64, from, to string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=? and status=?", id, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawReceipt 已广播的提现按回执改状态，记录实际 gas
+func (u *UserRepo) UpdateWithdrawReceipt(ctx context.Context, id uint64, to string, gasUsed uint64, gasCost string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=? and status=?", id, "broadcast").
+		Updates(map[string]interface{}{
+			"status":     to,
+			"gas_used":   gasUsed,
+			"gas_cost":   gasCost,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// GetWithdrawsByStatus .
+func (u *UserRepo) GetWithdrawsByStatus(status string, limit int) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	if err := u.data.db.Table("withdraw").Where("status=?", status).
+		Order("id asc").Limit(limit).Find(&withdraws).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, v := range withdraws {
+		res = append(res, toBizWithdraw(v))
+	}
+
+	return res, nil
+}
+
+// GetWithdrawById .
+func (u *UserRepo) GetWithdrawById(id uint64) (*biz.Withdraw, error) {
+	var withdraw *Withdraw
+	if err := u.data.db.Table("withdraw").Where("id=?", id).First(&withdraw).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return toBizWithdraw(withdraw), nil
+}
+
+// GetWithdrawPage .
+func (u *UserRepo) GetWithdrawPage(b *biz.Pagination, userId uint64, status string) ([]*biz.Withdraw, error, int64) {
+	var (
+		count     int64
+		withdraws []*Withdraw
+	)
+
+	res := make([]*biz.Withdraw, 0)
+
+	instance := u.data.db.Table("withdraw").Order("id desc")
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+
+	instance = instance.Count(&count)
+
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Find(&withdraws).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error()), 0
+	}
+
+	for _, v := range withdraws {
+		res = append(res, toBizWithdraw(v))
+	}
+
+	return res, nil, count
+}
+
+// UpdateWithdrawReview 审核状态从 from 改为 to，并发审核时只有一个成功
+func (u *UserRepo) UpdateWithdrawReview(ctx context.Context, id uint64, from, to string, remark string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=? and status=?", id, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"remark":     remark,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawDust 处理中的金额过小提现改为 rejected_dust
+func (u *UserRepo) UpdateWithdrawDust(ctx context.Context, id uint64, dust string, remark string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=? and status=?", id, "doing").
+		Updates(map[string]interface{}{
+			"status":     "rejected_dust",
+			"dust":       dust,
+			"remark":     remark,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawDustRefund 金额过小的提现从 carry 或 carried 改为已退回
+func (u *UserRepo) UpdateWithdrawDustRefund(ctx context.Context, id uint64, from string, remark string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=? and status=? and dust=?", id, "rejected_dust", from).
+		Updates(map[string]interface{}{
+			"dust":       "refunded",
+			"remark":     remark,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// GetWithdrawsByDust 金额过小的提现按处理方式查询
+func (u *UserRepo) GetWithdrawsByDust(dust string, limit int) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	if err := u.data.db.Table("withdraw").Where("status=? and dust=?", "rejected_dust", dust).
+		Order("id asc").Limit(limit).Find(&withdraws).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, v := range withdraws {
+		res = append(res, toBizWithdraw(v))
+	}
+
+	return res, nil
+}
+
+// GetWithdrawsByCarryId 并入这笔提现的金额过小提现
+func (u *UserRepo) GetWithdrawsByCarryId(ctx context.Context, carryId uint64) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	if err := u.data.DB(ctx).Table("withdraw").
+		Where("carry_id=? and status=? and dust=?", carryId, "rejected_dust", "carried").
+		Order("id asc").Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, v := range withdraws {
+		res = append(res, toBizWithdraw(v))
+	}
+
+	return res, nil
+}
+
+// WithdrawRefund 提现未发放写退款记录，收过的手续费冲回
+func (u *UserRepo) WithdrawRefund(ctx context.Context, w *biz.Withdraw, reason pb.RewardReason) error {
+	if 0 < w.Fee.Sign() {
+		var feeRecord FeeRecord
+		feeRecord.Account = "withdraw_fee"
+		feeRecord.UserId = w.UserId
+		feeRecord.WithdrawId = w.ID
+		feeRecord.Amount = w.Fee.Neg()
+		resFee := u.data.DB(ctx).Table("fee_record").Create(&feeRecord)
+		if resFee.Error != nil || 0 >= resFee.RowsAffected {
+			return errors.New(500, "CREATE_FEE_ERROR", "手续费记录创建失败")
+		}
+	}
+
+	var (
+		reward Reward
+	)
+
+	reward.UserId = w.UserId
+	reward.Amount = w.Amount
+	reward.Reason = reason
+	reward.Address = w.Address
+	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	return nil
+}
+
+func toBizWithdraw(withdraw *Withdraw) *biz.Withdraw {
+	res := &biz.Withdraw{
+		ID:        withdraw.ID,
+		UserId:    withdraw.UserId,
+		Amount:    withdraw.Amount,
+		RelAmount: withdraw.RelAmount,
+		Status:    withdraw.Status,
+		Address:   withdraw.Address,
+		TxHash:    withdraw.TxHash,
+		TxHistory: withdraw.TxHistory,
+		Nonce:     withdraw.Nonce,
+		GasPrice:  withdraw.GasPrice,
+		GasLimit:  withdraw.GasLimit,
+		Rpc:       withdraw.Rpc,
+		Attempts:  withdraw.Attempts,
+		RawTx:     withdraw.RawTx,
+		Remark:    withdraw.Remark,
+		Fee:       withdraw.Fee,
+		Dust:      withdraw.Dust,
+		CarryId:   withdraw.CarryId,
+		GasUsed:   withdraw.GasUsed,
+		GasCost:   withdraw.GasCost,
+		CreatedAt: withdraw.CreatedAt,
+		UpdatedAt: withdraw.UpdatedAt,
+	}
+
+	if nil != withdraw.BroadcastAt {
+		res.BroadcastAt = *withdraw.BroadcastAt
+	}
+
+	return res
+}
+
 // GetUserByUserIds .
 func (u *UserRepo) GetUserByUserIds(userIds ...uint64) (map[uint64]*biz.User, error) {
 	var users []*User
@@ -639,43 +1052,6 @@ func (u *UserRepo) HasCardByCardID(ctx context.Context, cardID string) (bool, er
 	return true, nil
 }
 
-// CreateCard .
-func (u *UserRepo) CreateCard(ctx context.Context, userId uint64, user *biz.User) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", user.Amount).Where("card_order_id=?", "no").
-		Updates(map[string]interface{}{
-			"amount":        gorm.Expr("amount - ?", user.Amount),
-			"card_order_id": "do",
-			"first_name":    user.FirstName,
-			"last_name":     user.LastName,
-			"birth_date":    user.BirthDate,
-			"email":         user.Email,
-			"phone":         user.Phone,
-			"country_code":  user.CountryCode,
-			"country":       user.Country,
-			"city":          user.City,
-			"street":        user.Street,
-			"postal_code":   user.PostalCode,
-			"updated_at":    time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
-
-	var (
-		reward Reward
-	)
-
-	reward.UserId = userId
-	reward.Amount = user.Amount
-	reward.Reason = 3 // 给我分红的理由
-	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
-	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
-		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
-	}
-
-	return nil
-}
-
 // SetVip .
 func (u *UserRepo) SetVip(ctx context.Context, userId uint64, vip uint64) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
@@ -706,12 +1082,11 @@ func (u *UserRepo) UpdateCard(ctx context.Context, userId uint64, cardOrderId, c
 }
 
 // UpdateCardNo .
-func (u *UserRepo) UpdateCardNo(ctx context.Context, userId uint64, amount float64) error {
+func (u *UserRepo) UpdateCardNo(ctx context.Context, userId uint64, amount money.Money) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
 		Updates(map[string]interface{}{
 			"card_order_id": "no",
 			"card":          "no",
-			"amount":        gorm.Expr("amount + ?", amount),
 			"updated_at":    time.Now().Format("2006-01-02 15:04:05"),
 		})
 	if res.Error != nil || 0 >= res.RowsAffected {
@@ -723,8 +1098,8 @@ func (u *UserRepo) UpdateCardNo(ctx context.Context, userId uint64, amount float
 	)
 
 	reward.UserId = userId
-	reward.Amount = 10
-	reward.Reason = 7 // 给我分红的理由
+	reward.Amount = money.New(10)
+	reward.Reason = pb.RewardReason_REWARD_REASON_CARD_REFUND // 给我分红的理由
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
@@ -909,23 +1284,13 @@ func (u *UserRepo) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*biz.Wit
 		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
 	}
 
-	return &biz.Withdraw{
-		ID:        withdraw.ID,
-		UserId:    withdraw.UserId,
-		Amount:    withdraw.Amount,
-		RelAmount: withdraw.RelAmount,
-		Status:    withdraw.Status,
-		Address:   withdraw.Address,
-		CreatedAt: withdraw.CreatedAt,
-		UpdatedAt: withdraw.UpdatedAt,
-	}, nil
+	return toBizWithdraw(withdraw), nil
 }
 
 // CreateCardRecommendNew .
-func (u *UserRepo) CreateCardRecommendNew(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error {
+func (u *UserRepo) CreateCardRecommendNew(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
 		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount + ?", amount),
 			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
 		})
 	if res.Error != nil || 0 >= res.RowsAffected {
@@ -938,7 +1303,7 @@ func (u *UserRepo) CreateCardRecommendNew(ctx context.Context, userId uint64, am
 	reward.UserId = userId
 	reward.Amount = amount
 	reward.One = vip
-	reward.Reason = 6 // 给我分红的理由
+	reward.Reason = pb.RewardReason_REWARD_REASON_CARD_RECOMMEND // 给我分红的理由
 	reward.Address = address
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
@@ -949,10 +1314,9 @@ func (u *UserRepo) CreateCardRecommendNew(ctx context.Context, userId uint64, am
 }
 
 // CreateCardRecommend .
-func (u *UserRepo) CreateCardRecommend(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error {
+func (u *UserRepo) CreateCardRecommend(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("vip=?", vip).
 		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount + ?", amount),
 			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
 		})
 	if res.Error != nil || 0 >= res.RowsAffected {
@@ -965,7 +1329,7 @@ func (u *UserRepo) CreateCardRecommend(ctx context.Context, userId uint64, amoun
 	reward.UserId = userId
 	reward.Amount = amount
 	reward.One = vip
-	reward.Reason = 6 // 给我分红的理由
+	reward.Reason = pb.RewardReason_REWARD_REASON_CARD_RECOMMEND // 给我分红的理由
 	reward.Address = address
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
@@ -976,10 +1340,9 @@ func (u *UserRepo) CreateCardRecommend(ctx context.Context, userId uint64, amoun
 }
 
 // CreateCardRecommendTwo .
-func (u *UserRepo) CreateCardRecommendTwo(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error {
+func (u *UserRepo) CreateCardRecommendTwo(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
 		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount + ?", amount),
 			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
 		})
 	if res.Error != nil || 0 >= res.RowsAffected {
@@ -992,7 +1355,7 @@ func (u *UserRepo) CreateCardRecommendTwo(ctx context.Context, userId uint64, am
 	reward.UserId = userId
 	reward.Amount = amount
 	reward.One = vip
-	reward.Reason = 11 // 给我分红的理由
+	reward.Reason = pb.RewardReason_REWARD_REASON_CARD_TWO_RECOMMEND // 给我分红的理由
 	reward.Address = address
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
@@ -1016,33 +1379,15 @@ func (u *UserRepo) UpdateCardTwo(ctx context.Context, id uint64) error {
 	return nil
 }
 
-// AmountTo .
-func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount float64) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", amount).
-		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount - ?", amount),
-			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
-
-	resTwo := u.data.DB(ctx).Table("user").Where("id=?", toUserId).
-		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount + ?", amount),
-			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if resTwo.Error != nil || 0 >= resTwo.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
-
+// AmountTo 余额由 LedgerPost 转移，这里只写记录
+func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount money.Money) error {
 	var (
 		reward Reward
 	)
 
 	reward.UserId = userId
 	reward.Amount = amount
-	reward.Reason = 5 // 给我分红的理由
+	reward.Reason = pb.RewardReason_REWARD_REASON_TRANSFER // 给我分红的理由
 	reward.Address = toAddress
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
@@ -1052,26 +1397,56 @@ func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddr
 	return nil
 }
 
-// Withdraw .
-func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRel float64, address string) error {
-	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", amount).
-		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount - ?", amount),
-			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+// Withdraw 余额由 LedgerPost 扣减，这里写提现和手续费记录，返回提现 id
+func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRel, fee money.Money, address string) (uint64, error) {
+	// 之前金额过小留下的并入这次到账
+	var carries []*Withdraw
+	if err := u.data.DB(ctx).Table("withdraw").Where("user_id=? and status=? and dust=?", userId, "rejected_dust", "carry").
+		Find(&carries).Error; err != nil {
+		return 0, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	carryIds := make([]uint64, 0, len(carries))
+	for _, v := range carries {
+		amountRel = amountRel.Add(v.RelAmount)
+		carryIds = append(carryIds, v.ID)
 	}
 
 	var withdraw Withdraw
 	withdraw.UserId = userId
 	withdraw.Amount = amount
 	withdraw.RelAmount = amountRel
+	withdraw.Fee = fee
 	withdraw.Status = "rewarded"
 	withdraw.Address = address
 	resTwo := u.data.DB(ctx).Table("withdraw").Create(&withdraw)
 	if resTwo.Error != nil || 0 >= resTwo.RowsAffected {
-		return errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录创建失败")
+		return 0, errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录创建失败")
+	}
+
+	if 0 < len(carryIds) {
+		resCarry := u.data.DB(ctx).Table("withdraw").Where("id in (?) and dust=?", carryIds, "carry").
+			Updates(map[string]interface{}{
+				"dust":       "carried",
+				"carry_id":   withdraw.ID,
+				"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+			})
+		if resCarry.Error != nil || int64(len(carryIds)) != resCarry.RowsAffected {
+			return 0, errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+		}
+	}
+
+	// 手续费单独记到平台手续费账户
+	if 0 < fee.Sign() {
+		var feeRecord FeeRecord
+		feeRecord.Account = "withdraw_fee"
+		feeRecord.UserId = userId
+		feeRecord.WithdrawId = withdraw.ID
+		feeRecord.Amount = fee
+		resFee := u.data.DB(ctx).Table("fee_record").Create(&feeRecord)
+		if resFee.Error != nil || 0 >= resFee.RowsAffected {
+			return 0, errors.New(500, "CREATE_FEE_ERROR", "手续费记录创建失败")
+		}
 	}
 
 	var (
@@ -1080,18 +1455,29 @@ func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRe
 
 	reward.UserId = userId
 	reward.Amount = amount
-	reward.Reason = 2 // 给我分红的理由
+	reward.Reason = pb.RewardReason_REWARD_REASON_WITHDRAW // 给我分红的理由
 	reward.Address = address
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
-		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+		return 0, errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
-	return nil
+	return withdraw.ID, nil
+}
+
+// GetFeeTotal 平台手续费账户合计
+func (u *UserRepo) GetFeeTotal(account string) (money.Money, error) {
+	var total money.Money
+	err := u.data.db.Table("fee_record").Where("account=?", account).Select("COALESCE(SUM(amount), 0)").Row().Scan(&total)
+	if nil != err {
+		return money.Zero(), errors.New(500, "FEE_ERROR", err.Error())
+	}
+
+	return total, nil
 }
 
 // GetUserRewardByUserIdPage .
-func (u *UserRepo) GetUserRewardByUserIdPage(ctx context.Context, b *biz.Pagination, userId uint64, reason uint64) ([]*biz.Reward, error, int64) {
+func (u *UserRepo) GetUserRewardByUserIdPage(ctx context.Context, b *biz.Pagination, userId uint64, reason pb.RewardReason) ([]*biz.Reward, error, int64) {
 	var (
 		count   int64
 		rewards []*Reward
@@ -1169,32 +1555,20 @@ func (u *UserRepo) GetUserByAddresses(addresses ...string) (map[string]*biz.User
 }
 
 func (u *UserRepo) CreateEthUserRecordListByHash(ctx context.Context, r *biz.EthUserRecord) (*biz.EthUserRecord, error) {
-	res := u.data.DB(ctx).Table("user").Where("id=?", r.UserId).
-		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount + ?", float64(r.AmountTwo)),
-			"amount_two": gorm.Expr("amount_two + ?", r.AmountTwo),
-			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
-		})
-	if res.Error != nil || 0 >= res.RowsAffected {
-		return nil, errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
-	}
-	var (
-		reward Reward
-	)
-	reward.UserId = uint64(r.UserId)
-	reward.Amount = float64(r.AmountTwo)
-	reward.Reason = 1 // 给我分红的理由
-	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
-	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
-		return nil, errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
-	}
-
 	var ethUserRecord EthUserRecord
 	ethUserRecord.UserId = r.UserId
 	ethUserRecord.Hash = r.Hash
 	ethUserRecord.Amount = r.Amount
 	ethUserRecord.AmountTwo = r.AmountTwo
 	ethUserRecord.Last = r.Last
+	ethUserRecord.BlockNumber = r.BlockNumber
+	ethUserRecord.ChainId = r.ChainId
+	ethUserRecord.Contract = r.Contract
+	if 0 <= r.DepositIndex && "" != r.Contract {
+		ethUserRecord.DepositIndex = &r.DepositIndex
+	}
+	ethUserRecord.Uncredited = r.Uncredited
+	ethUserRecord.Decimals = r.Decimals
 
 	resTwo := u.data.DB(ctx).Table("eth_user_record").Create(&ethUserRecord)
 	if resTwo.Error != nil || 0 >= resTwo.RowsAffected {
@@ -1202,20 +1576,357 @@ func (u *UserRepo) CreateEthUserRecordListByHash(ctx context.Context, r *biz.Eth
 	}
 
 	return &biz.EthUserRecord{
-		ID:        ethUserRecord.ID,
-		UserId:    ethUserRecord.UserId,
-		Hash:      ethUserRecord.Hash,
-		Amount:    ethUserRecord.Amount,
-		AmountTwo: ethUserRecord.AmountTwo,
-		Last:      ethUserRecord.Last,
+		ID:           ethUserRecord.ID,
+		UserId:       ethUserRecord.UserId,
+		Hash:         ethUserRecord.Hash,
+		Amount:       ethUserRecord.Amount,
+		AmountTwo:    ethUserRecord.AmountTwo,
+		Last:         ethUserRecord.Last,
+		BlockNumber:  ethUserRecord.BlockNumber,
+		ChainId:      ethUserRecord.ChainId,
+		Contract:     ethUserRecord.Contract,
+		DepositIndex: r.DepositIndex,
+		Uncredited:   ethUserRecord.Uncredited,
+		Decimals:     ethUserRecord.Decimals,
+		CreatedAt:    ethUserRecord.CreatedAt,
+	}, nil
+}
+
+// DepositCredit 写充值记录，余额由 LedgerPost 入账，amount 为十进制字符串，按 decimal 计算不丢精度
+func (u *UserRepo) DepositCredit(ctx context.Context, userId uint64, amount money.Money, amountTwo uint64, hash string) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
+		Updates(map[string]interface{}{
+			"amount_two": gorm.Expr("amount_two + ?", amountTwo),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	now := time.Now()
+	resInsert := u.data.DB(ctx).Table("reward").Create(map[string]interface{}{
+		"user_id":    userId,
+		"amount":     gorm.Expr("CAST(? AS DECIMAL(65,20))", amount),
+		"reason":     pb.RewardReason_REWARD_REASON_DEPOSIT, // 给我分红的理由
+		"address":    hash,
+		"one":        0,
+		"created_at": now,
+		"updated_at": now,
+	})
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	return nil
+}
+
+// GetEthUserRecordsUncredited .
+func (u *UserRepo) GetEthUserRecordsUncredited(limit int) ([]*biz.EthUserRecord, error) {
+	var ethUserRecords []*EthUserRecord
+	res := make([]*biz.EthUserRecord, 0)
+	if err := u.data.db.Table("eth_user_record").Where("uncredited=?", 1).
+		Order("id asc").Limit(limit).Find(&ethUserRecords).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	for _, v := range ethUserRecords {
+		res = append(res, toBizEthUserRecord(v))
+	}
+
+	return res, nil
+}
+
+// UpdateEthUserRecordCredited .
+func (u *UserRepo) UpdateEthUserRecordCredited(ctx context.Context, id int64) error {
+	res := u.data.DB(ctx).Table("eth_user_record").Where("id=?", id).Where("uncredited=?", 1).
+		Updates(map[string]interface{}{
+			"uncredited": 0,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_ETH_USER_RECORD_ERROR", "充值记录修改失败")
+	}
+
+	return nil
+}
+
+// GetEthUserRecordByHash .
+func (u *UserRepo) GetEthUserRecordByHash(hash string) (*biz.EthUserRecord, error) {
+	var ethUserRecord *EthUserRecord
+	if err := u.data.db.Table("eth_user_record").Where("hash=?", hash).First(&ethUserRecord).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	return toBizEthUserRecord(ethUserRecord), nil
+}
+
+// GetEthUserRecordByIndex .
+func (u *UserRepo) GetEthUserRecordByIndex(chainId int64, contract string, index int64) (*biz.EthUserRecord, error) {
+	var ethUserRecord *EthUserRecord
+	if err := u.data.db.Table("eth_user_record").
+		Where("chain_id=? and contract=? and deposit_index=?", chainId, contract, index).
+		First(&ethUserRecord).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	return toBizEthUserRecord(ethUserRecord), nil
+}
+
+// GetEthUserRecords 该合约的记录和没有合约信息的旧记录
+func (u *UserRepo) GetEthUserRecords(chainId int64, contract string) ([]*biz.EthUserRecord, error) {
+	var ethUserRecords []*EthUserRecord
+	res := make([]*biz.EthUserRecord, 0)
+	if err := u.data.db.Table("eth_user_record").
+		Where("(chain_id=? and contract=?) or contract=?", chainId, contract, "").
+		Order("id asc").Find(&ethUserRecords).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	for _, v := range ethUserRecords {
+		res = append(res, toBizEthUserRecord(v))
+	}
+
+	return res, nil
+}
+
+func toBizEthUserRecord(ethUserRecord *EthUserRecord) *biz.EthUserRecord {
+	var depositIndex int64 = -1
+	if nil != ethUserRecord.DepositIndex {
+		depositIndex = *ethUserRecord.DepositIndex
+	}
+
+	return &biz.EthUserRecord{
+		ID:           ethUserRecord.ID,
+		UserId:       ethUserRecord.UserId,
+		Hash:         ethUserRecord.Hash,
+		Amount:       ethUserRecord.Amount,
+		AmountTwo:    ethUserRecord.AmountTwo,
+		Last:         ethUserRecord.Last,
+		BlockNumber:  ethUserRecord.BlockNumber,
+		ChainId:      ethUserRecord.ChainId,
+		Contract:     ethUserRecord.Contract,
+		DepositIndex: depositIndex,
+		Uncredited:   ethUserRecord.Uncredited,
+		Decimals:     ethUserRecord.Decimals,
+		CreatedAt:    ethUserRecord.CreatedAt,
+	}
+}
+
+// GetEthBlockCursor .
+func (u *UserRepo) GetEthBlockCursor(chainId int64, contract string) (*biz.EthBlockCursor, error) {
+	var cursor *EthBlockCursor
+	if err := u.data.db.Table("eth_block_cursor").Where("chain_id=? and contract=?", chainId, contract).First(&cursor).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "ETH BLOCK CURSOR ERROR", err.Error())
+	}
+
+	return &biz.EthBlockCursor{
+		ID:          cursor.ID,
+		ChainId:     cursor.ChainId,
+		Contract:    cursor.Contract,
+		BlockNumber: cursor.BlockNumber,
+		BlockHash:   cursor.BlockHash,
+		CreatedAt:   cursor.CreatedAt,
+		UpdatedAt:   cursor.UpdatedAt,
 	}, nil
 }
 
+// SaveEthBlockCursor .
+func (u *UserRepo) SaveEthBlockCursor(ctx context.Context, cursor *biz.EthBlockCursor) error {
+	if 0 >= cursor.ID {
+		var insert EthBlockCursor
+		insert.ChainId = cursor.ChainId
+		insert.Contract = cursor.Contract
+		insert.BlockNumber = cursor.BlockNumber
+		insert.BlockHash = cursor.BlockHash
+		res := u.data.DB(ctx).Table("eth_block_cursor").Create(&insert)
+		if res.Error != nil || 0 >= res.RowsAffected {
+			return errors.New(500, "CREATE_ETH_BLOCK_CURSOR_ERROR", "区块游标创建失败")
+		}
+
+		cursor.ID = insert.ID
+		return nil
+	}
+
+	res := u.data.DB(ctx).Table("eth_block_cursor").Where("id=?", cursor.ID).
+		Updates(map[string]interface{}{
+			"block_number": cursor.BlockNumber,
+			"block_hash":   cursor.BlockHash,
+			"updated_at":   time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_ETH_BLOCK_CURSOR_ERROR", "区块游标修改失败")
+	}
+
+	return nil
+}
+
+// CreateEthDepositPending .
+func (u *UserRepo) CreateEthDepositPending(ctx context.Context, p *biz.EthDepositPending) (*biz.EthDepositPending, error) {
+	var pending EthDepositPending
+	pending.UserId = p.UserId
+	pending.Address = p.Address
+	pending.Hash = p.Hash
+	pending.Amount = p.Amount
+	pending.Decimals = p.Decimals
+	pending.BlockNumber = p.BlockNumber
+	pending.ChainId = p.ChainId
+	pending.Contract = p.Contract
+	pending.DepositIndex = p.DepositIndex
+	pending.Status = p.Status
+	pending.Remark = p.Remark
+
+	res := u.data.DB(ctx).Table("eth_deposit_pending").Create(&pending)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return nil, errors.New(500, "CREATE_ETH_DEPOSIT_PENDING_ERROR", "小额充值记录创建失败")
+	}
+
+	return toBizEthDepositPending(&pending), nil
+}
+
+// GetEthDepositPendingById .
+func (u *UserRepo) GetEthDepositPendingById(id int64) (*biz.EthDepositPending, error) {
+	var pending *EthDepositPending
+	if err := u.data.db.Table("eth_deposit_pending").Where("id=?", id).First(&pending).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "ETH DEPOSIT PENDING ERROR", err.Error())
+	}
+
+	return toBizEthDepositPending(pending), nil
+}
+
+// GetEthDepositPendingByIndex .
+func (u *UserRepo) GetEthDepositPendingByIndex(chainId int64, contract string, index int64) (*biz.EthDepositPending, error) {
+	var pending *EthDepositPending
+	if err := u.data.db.Table("eth_deposit_pending").
+		Where("chain_id=? and contract=? and deposit_index=?", chainId, contract, index).First(&pending).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "ETH DEPOSIT PENDING ERROR", err.Error())
+	}
+
+	return toBizEthDepositPending(pending), nil
+}
+
+// GetEthDepositPendingByUser .
+func (u *UserRepo) GetEthDepositPendingByUser(userId int64, chainId int64, contract string, status string) ([]*biz.EthDepositPending, error) {
+	var pending []*EthDepositPending
+	res := make([]*biz.EthDepositPending, 0)
+	if err := u.data.db.Table("eth_deposit_pending").
+		Where("user_id=? and chain_id=? and contract=? and status=?", userId, chainId, contract, status).
+		Order("id asc").Find(&pending).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "ETH DEPOSIT PENDING ERROR", err.Error())
+	}
+
+	for _, v := range pending {
+		res = append(res, toBizEthDepositPending(v))
+	}
+
+	return res, nil
+}
+
+// GetEthDepositPendingPage .
+func (u *UserRepo) GetEthDepositPendingPage(b *biz.Pagination, userId int64, status string) ([]*biz.EthDepositPending, error, int64) {
+	var (
+		count   int64
+		pending []*EthDepositPending
+	)
+
+	res := make([]*biz.EthDepositPending, 0)
+
+	instance := u.data.db.Table("eth_deposit_pending").Order("id desc")
+	if 0 < userId {
+		instance = instance.Where("user_id=?", userId)
+	}
+
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+
+	instance = instance.Count(&count)
+
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Find(&pending).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "ETH DEPOSIT PENDING ERROR", err.Error()), 0
+	}
+
+	for _, v := range pending {
+		res = append(res, toBizEthDepositPending(v))
+	}
+
+	return res, nil, count
+}
+
+// UpdateEthDepositPendingStatus 状态从 from 改为 to，并发处理时只有一个成功
+func (u *UserRepo) UpdateEthDepositPendingStatus(ctx context.Context, id int64, from, to string, remark string) error {
+	res := u.data.DB(ctx).Table("eth_deposit_pending").Where("id=? and status=?", id, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"remark":     remark,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_ETH_DEPOSIT_PENDING_ERROR", "小额充值记录修改失败")
+	}
+
+	return nil
+}
+
+func toBizEthDepositPending(pending *EthDepositPending) *biz.EthDepositPending {
+	return &biz.EthDepositPending{
+		ID:           pending.ID,
+		UserId:       pending.UserId,
+		Address:      pending.Address,
+		Hash:         pending.Hash,
+		Amount:       pending.Amount,
+		Decimals:     pending.Decimals,
+		BlockNumber:  pending.BlockNumber,
+		ChainId:      pending.ChainId,
+		Contract:     pending.Contract,
+		DepositIndex: pending.DepositIndex,
+		Status:       pending.Status,
+		Remark:       pending.Remark,
+		CreatedAt:    pending.CreatedAt,
+		UpdatedAt:    pending.UpdatedAt,
+	}
+}
+
 // UpdateUserMyTotalAmountAdd .
-func (u *UserRepo) UpdateUserMyTotalAmountAdd(ctx context.Context, userId uint64, amount uint64) error {
+func (u *UserRepo) UpdateUserMyTotalAmountAdd(ctx context.Context, userId uint64, amount money.Money) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
 		Updates(map[string]interface{}{
-			"my_total_amount": gorm.Expr("my_total_amount + ?", amount),
+			"my_total_amount": gorm.Expr("my_total_amount + CAST(? AS DECIMAL(65,20))", amount),
 			"updated_at":      time.Now().Format("2006-01-02 15:04:05"),
 		})
 	if res.Error != nil || 0 >= res.RowsAffected {
@@ -1253,7 +1964,7 @@ func (u *UserRepo) GetUserCardTwo() ([]*biz.Reward, error) {
 	res := make([]*biz.Reward, 0)
 
 	instance := u.data.db.Table("reward").Order("id asc")
-	instance = instance.Where("reason=?", 9).Where("one=?", 0)
+	instance = instance.Where("reason=?", pb.RewardReason_REWARD_REASON_OPEN_CARD_TWO).Where("one=?", 0)
 	if err := instance.Find(&rewards).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return res, nil
@@ -1357,6 +2068,7 @@ func (u *UserRepo) GetUsers(b *biz.Pagination, address string, cardTwo uint64, c
 			PicTwo:           user.PicTwo,
 			LockCard:         user.LockCard,
 			LockCardTwo:      user.LockCardTwo,
+			LockTransfer:     user.LockTransfer,
 			ChangeCardTwo:    user.ChangeCardTwo,
 			ChangeCard:       user.ChangeCard,
 			CardNumberRel:    user.CardNumberRel,
@@ -1412,6 +2124,20 @@ func (u *UserRepo) SetUserCount(ctx context.Context, userId uint64) (bool, error
 	return true, nil
 }
 
+// SetLockTransfer .
+func (u *UserRepo) SetLockTransfer(ctx context.Context, userId uint64, lock uint64) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
+		Updates(map[string]interface{}{
+			"lock_transfer": lock,
+			"updated_at":    time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户修改失败")
+	}
+
+	return nil
+}
+
 // GetConfigs .
 func (u *UserRepo) GetConfigs() ([]*biz.Config, error) {
 	var configs []*Config
@@ -1713,7 +2439,7 @@ func (u *UserRepo) CreateCardNew(ctx context.Context, userId, id uint64, in *biz
 }
 
 // UpdateUserDoing 创建一条卡片记录
-func (u *UserRepo) UpdateUserDoing(ctx context.Context, userId uint64, cardNumber, cardNumberRel string, cardAmount float64) error {
+func (u *UserRepo) UpdateUserDoing(ctx context.Context, userId uint64, cardNumber, cardNumberRel string, cardAmount money.Money) error {
 	resTwo := u.data.DB(ctx).Table("user").Where("id=?", userId).
 		Updates(map[string]interface{}{
 			"card_order_id":   "doing",
@@ -1730,7 +2456,7 @@ func (u *UserRepo) UpdateUserDoing(ctx context.Context, userId uint64, cardNumbe
 }
 
 // UpdateUserDone 创建一条卡片记录
-func (u *UserRepo) UpdateUserDone(ctx context.Context, userId uint64, cardId string, cardAmount float64) error {
+func (u *UserRepo) UpdateUserDone(ctx context.Context, userId uint64, cardId string, cardAmount money.Money) error {
 	resTwo := u.data.DB(ctx).Table("user").Where("id=?", userId).
 		Updates(map[string]interface{}{
 			"card_order_id": "success",
@@ -1757,7 +2483,7 @@ func (u *UserRepo) UpdateUserDone(ctx context.Context, userId uint64, cardId str
 }
 
 // UpdateCardStatus 创建一条卡片记录
-func (u *UserRepo) UpdateCardStatus(ctx context.Context, id, userId uint64, cardNumber, cardNumberRel string, cardAmount float64) error {
+func (u *UserRepo) UpdateCardStatus(ctx context.Context, id, userId uint64, cardNumber, cardNumberRel string, cardAmount money.Money) error {
 	res := u.data.DB(ctx).Table("card_two").Where("id=?", id).
 		Updates(map[string]interface{}{
 			"card_amount": cardAmount,
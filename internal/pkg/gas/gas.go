@@ -0,0 +1,155 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+var ErrPriceTooHigh = errors.New("gas: price above max")
+
+const (
+	ModeLegacy  = "legacy"
+	ModeEip1559 = "eip1559"
+)
+
+// Config 价格和用量的倍数按百分比，100为原值
+type Config struct {
+	Mode            string   // legacy 或 eip1559，节点不支持 eip1559 时退回 legacy
+	MaxPrice        *big.Int // 单价上限 wei，nil 或 0 不限制
+	PriceMultiplier uint64   // 建议价的倍数
+	LimitMultiplier uint64   // 估算用量的倍数
+	MaxLimit        uint64   // 用量上限，估算超过时不发
+}
+
+// Price 一次发送用的价格，legacy 只用 GasPrice
+type Price struct {
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+// Strategy 按配置给交易定价和估算用量
+type Strategy struct {
+	c Config
+}
+
+func New(c Config) *Strategy {
+	if 0 >= c.PriceMultiplier {
+		c.PriceMultiplier = 100
+	}
+	if 0 >= c.LimitMultiplier {
+		c.LimitMultiplier = 100
+	}
+
+	return &Strategy{c: c}
+}
+
+// Price 当前价格，超过上限返回 ErrPriceTooHigh，网络拥堵时不发
+func (s *Strategy) Price(ctx context.Context, client bind.ContractTransactor) (*Price, error) {
+	if ModeEip1559 == s.c.Mode {
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if nil != header.BaseFee {
+			return s.dynamic(ctx, client, header.BaseFee)
+		}
+	}
+
+	suggest, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	price := s.multiply(suggest)
+	if s.over(price) {
+		return nil, fmt.Errorf("%w: %s > %s", ErrPriceTooHigh, price, s.c.MaxPrice)
+	}
+
+	return &Price{GasPrice: price}, nil
+}
+
+// dynamic 小费按建议值放大，上限按两倍 base fee 留余量，超过上限时压到上限，连 base fee 加小费都不够才不发
+func (s *Strategy) dynamic(ctx context.Context, client bind.ContractTransactor, baseFee *big.Int) (*Price, error) {
+	suggest, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tipCap := s.multiply(suggest)
+	feeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tipCap)
+	if s.over(feeCap) {
+		least := new(big.Int).Add(baseFee, tipCap)
+		if s.over(least) {
+			return nil, fmt.Errorf("%w: %s > %s", ErrPriceTooHigh, least, s.c.MaxPrice)
+		}
+
+		feeCap = new(big.Int).Set(s.c.MaxPrice)
+	}
+
+	return &Price{GasTipCap: tipCap, GasFeeCap: feeCap}, nil
+}
+
+// Limit 估算用量，按倍数留余量
+func (s *Strategy) Limit(ctx context.Context, client bind.ContractTransactor, msg ethereum.CallMsg) (uint64, error) {
+	estimate, err := client.EstimateGas(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	limit := estimate * s.c.LimitMultiplier / 100
+	if 0 < s.c.MaxLimit && limit > s.c.MaxLimit {
+		if estimate > s.c.MaxLimit {
+			return 0, fmt.Errorf("gas: estimate %d above max limit %d", estimate, s.c.MaxLimit)
+		}
+
+		limit = s.c.MaxLimit
+	}
+
+	return limit, nil
+}
+
+// Apply 价格写入交易参数
+func (s *Strategy) Apply(opts *bind.TransactOpts, p *Price) {
+	opts.GasPrice = p.GasPrice
+	opts.GasTipCap = p.GasTipCap
+	opts.GasFeeCap = p.GasFeeCap
+}
+
+// Cap 重发提价后不超过上限，已在上限的返回 false
+func (s *Strategy) Cap(price *big.Int, old *big.Int) (*big.Int, bool) {
+	if !s.over(price) {
+		return price, true
+	}
+
+	if 0 <= old.Cmp(s.c.MaxPrice) {
+		return old, false
+	}
+
+	return new(big.Int).Set(s.c.MaxPrice), true
+}
+
+// Cost 实际花费的 gas 费 wei，baseFee 为交易所在块的 base fee，legacy 链为 nil
+func Cost(tx *types.Transaction, gasUsed uint64, baseFee *big.Int) *big.Int {
+	price := tx.GasPrice()
+	if nil != baseFee {
+		price = new(big.Int).Add(baseFee, tx.EffectiveGasTipValue(baseFee))
+	}
+
+	return new(big.Int).Mul(price, new(big.Int).SetUint64(gasUsed))
+}
+
+func (s *Strategy) multiply(price *big.Int) *big.Int {
+	res := new(big.Int).Mul(price, new(big.Int).SetUint64(s.c.PriceMultiplier))
+	return res.Quo(res, big.NewInt(100))
+}
+
+func (s *Strategy) over(price *big.Int) bool {
+	return nil != s.c.MaxPrice && 0 < s.c.MaxPrice.Sign() && 0 < price.Cmp(s.c.MaxPrice)
+}
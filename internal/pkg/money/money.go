@@ -0,0 +1,270 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scale 小数位数，和数据库 decimal(65,20) 一致
+const Scale = 20
+
+var unit = new(big.Int).Exp(big.NewInt(10), big.NewInt(Scale), nil)
+
+// Money 定点金额，按 Scale 位小数存整数，零值为0，运算都返回新值
+type Money struct {
+	v *big.Int
+}
+
+func Zero() Money {
+	return Money{}
+}
+
+// New 整数金额
+func New(i int64) Money {
+	return Money{v: new(big.Int).Mul(big.NewInt(i), unit)}
+}
+
+// FromFloat 兼容旧的 float 数据，按最短十进制表示转换，超出 Scale 的小数位四舍五入
+func FromFloat(f float64) Money {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if i := strings.Index(s, "."); 0 <= i && len(s)-i-1 > Scale {
+		s = strconv.FormatFloat(f, 'f', Scale, 64)
+	}
+
+	res, _ := Parse(s)
+	return res
+}
+
+// Parse 十进制字符串，最多一个正负号，整数和小数部分都不能为空，小数位超过 Scale 的拒绝
+func Parse(s string) (Money, error) {
+	raw := s
+	s = strings.TrimSpace(s)
+	if "" == s {
+		return Money{}, fmt.Errorf("money: empty")
+	}
+
+	neg := false
+	if '-' == s[0] || '+' == s[0] {
+		neg = '-' == s[0]
+		s = s[1:]
+	}
+
+	integer, fraction, dot := strings.Cut(s, ".")
+	if !isDigits(integer) || (dot && !isDigits(fraction)) {
+		return Money{}, fmt.Errorf("money: invalid %q", raw)
+	}
+
+	if len(fraction) > Scale {
+		return Money{}, fmt.Errorf("money: %q has more than %d decimal places", raw, Scale)
+	}
+	fraction += strings.Repeat("0", Scale-len(fraction))
+
+	v, ok := new(big.Int).SetString(integer+fraction, 10)
+	if !ok {
+		return Money{}, fmt.Errorf("money: invalid %q", raw)
+	}
+
+	if neg {
+		v.Neg(v)
+	}
+
+	return Money{v: v}, nil
+}
+
+func isDigits(s string) bool {
+	if "" == s {
+		return false
+	}
+
+	for _, c := range s {
+		if '0' > c || '9' < c {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MustParse 常量用，格式错误 panic
+func MustParse(s string) Money {
+	res, err := Parse(s)
+	if nil != err {
+		panic(err)
+	}
+
+	return res
+}
+
+func (m Money) int() *big.Int {
+	if nil == m.v {
+		return new(big.Int)
+	}
+
+	return m.v
+}
+
+func (m Money) Add(o Money) Money {
+	return Money{v: new(big.Int).Add(m.int(), o.int())}
+}
+
+func (m Money) Sub(o Money) Money {
+	return Money{v: new(big.Int).Sub(m.int(), o.int())}
+}
+
+// Mul 乘比例或单价，超出 Scale 的小数位截断
+func (m Money) Mul(o Money) Money {
+	res := new(big.Int).Mul(m.int(), o.int())
+	return Money{v: res.Quo(res, unit)}
+}
+
+func (m Money) MulInt(i int64) Money {
+	return Money{v: new(big.Int).Mul(m.int(), big.NewInt(i))}
+}
+
+func (m Money) Neg() Money {
+	return Money{v: new(big.Int).Neg(m.int())}
+}
+
+func (m Money) Cmp(o Money) int {
+	return m.int().Cmp(o.int())
+}
+
+func (m Money) Sign() int {
+	return m.int().Sign()
+}
+
+func (m Money) IsZero() bool {
+	return 0 == m.Sign()
+}
+
+// String 完整精度，去掉末尾的0
+func (m Money) String() string {
+	res := m.fixed(Scale)
+	if strings.Contains(res, ".") {
+		res = strings.TrimRight(res, "0")
+		res = strings.TrimSuffix(res, ".")
+	}
+
+	return res
+}
+
+// StringFixed 保留 places 位小数，四舍五入
+func (m Money) StringFixed(places int) string {
+	if 0 > places {
+		places = 0
+	}
+	if places >= Scale {
+		return m.fixed(Scale)
+	}
+
+	v := m.int()
+	neg := 0 > v.Sign()
+	abs := new(big.Int).Abs(v)
+
+	step := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(Scale-places)), nil)
+	abs.Add(abs, new(big.Int).Quo(step, big.NewInt(2)))
+	abs.Quo(abs, step)
+	abs.Mul(abs, step)
+	if neg {
+		abs.Neg(abs)
+	}
+
+	return Money{v: abs}.fixed(places)
+}
+
+func (m Money) fixed(places int) string {
+	v := m.int()
+	neg := 0 > v.Sign()
+	integer, fraction := new(big.Int).QuoRem(new(big.Int).Abs(v), unit, new(big.Int))
+
+	res := integer.String()
+	if 0 < places {
+		tmp := fraction.String()
+		tmp = strings.Repeat("0", Scale-len(tmp)) + tmp
+		res += "." + tmp[:places]
+	}
+
+	if neg && 0 != v.Sign() {
+		return "-" + res
+	}
+
+	return res
+}
+
+// Uint64 整数金额，给只收整数的渠道用，负数、有小数或超出范围的报错
+func (m Money) Uint64() (uint64, error) {
+	integer, fraction := new(big.Int).QuoRem(m.int(), unit, new(big.Int))
+	if 0 != fraction.Sign() {
+		return 0, fmt.Errorf("money: %s is not a whole amount", m)
+	}
+	if 0 > integer.Sign() || !integer.IsUint64() {
+		return 0, fmt.Errorf("money: %s out of uint64 range", m)
+	}
+
+	return integer.Uint64(), nil
+}
+
+// Float64 只用于展示和兼容旧接口，运算不要用
+func (m Money) Float64() float64 {
+	res, _ := strconv.ParseFloat(m.String(), 64)
+	return res
+}
+
+// Value 写库用字符串，数据库按 decimal 处理不丢精度
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan 读 decimal 列
+func (m *Money) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case int64:
+		*m = New(v)
+		return nil
+	case float64:
+		*m = FromFloat(v)
+		return nil
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+
+	res, err := Parse(s)
+	if nil != err {
+		return err
+	}
+
+	*m = res
+	return nil
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+func (m *Money) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); nil != err {
+		// 兼容数字
+		s = string(b)
+	}
+
+	res, err := Parse(s)
+	if nil != err {
+		return err
+	}
+
+	*m = res
+	return nil
+}
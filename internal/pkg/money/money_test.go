@@ -0,0 +1,201 @@
+package money
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"整数", "12", "12", false},
+		{"小数", "12.50", "12.5", false},
+		{"负数", "-0.001", "-0.001", false},
+		{"正号", "+3", "3", false},
+		{"前后空格", " 7.25 ", "7.25", false},
+		{"负零", "-0", "0", false},
+		{"满精度", "1." + strings.Repeat("0", Scale-1) + "1", "1." + strings.Repeat("0", Scale-1) + "1", false},
+		{"空", "", "", true},
+		{"只有空格", "  ", "", true},
+		{"只有小数点", ".", "", true},
+		{"只有负号", "-", "", true},
+		{"负号加小数点", "-.", "", true},
+		{"两个符号", "-+5", "", true},
+		{"符号后空格", "- 5", "", true},
+		{"整数部分为空", ".5", "", true},
+		{"小数部分为空", "5.", "", true},
+		{"两个小数点", "1.2.3", "", true},
+		{"科学计数", "1e5", "", true},
+		{"非数字", "abc", "", true},
+		{"千分位", "1,000", "", true},
+		{"超出精度", "1." + strings.Repeat("0", Scale) + "1", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			if tt.wantErr != (nil != err) {
+				t.Fatalf("Parse(%q) err = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if !tt.wantErr && tt.want != got.String() {
+				t.Fatalf("Parse(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringFixed(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int
+		want   string
+	}{
+		{"1.005", 2, "1.01"},
+		{"1.004", 2, "1.00"},
+		{"-1.005", 2, "-1.01"},
+		{"-0.001", 2, "0.00"},
+		{"2.5", 0, "3"},
+		{"7", 3, "7.000"},
+	}
+
+	for _, tt := range tests {
+		if got := MustParse(tt.in).StringFixed(tt.places); tt.want != got {
+			t.Fatalf("StringFixed(%s, %d) = %s, want %s", tt.in, tt.places, got, tt.want)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	a, b := MustParse("10.1"), MustParse("0.2")
+
+	tests := []struct {
+		name string
+		got  Money
+		want string
+	}{
+		{"Add", a.Add(b), "10.3"},
+		{"Sub", b.Sub(a), "-9.9"},
+		{"Mul", a.Mul(b), "2.02"},
+		{"MulInt", b.MulInt(3), "0.6"},
+		{"Neg", a.Neg(), "-10.1"},
+		{"Zero", Zero().Add(Zero()), "0"},
+	}
+
+	for _, tt := range tests {
+		if tt.want != tt.got.String() {
+			t.Fatalf("%s = %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if 0 <= b.Cmp(a) || 1 != a.Sign() || !Zero().IsZero() {
+		t.Fatal("Cmp/Sign/IsZero")
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want string
+	}{
+		{0.1, "0.1"},
+		{-2.75, "-2.75"},
+		{1e-21, "0"},
+		{1.5e-20, "0.00000000000000000002"},
+	}
+
+	for _, tt := range tests {
+		if got := FromFloat(tt.in); tt.want != got.String() {
+			t.Fatalf("FromFloat(%v) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestUint64(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    uint64
+		wantErr bool
+	}{
+		{"10", 10, false},
+		{"10.000", 10, false},
+		{"0", 0, false},
+		{"18446744073709551615", 18446744073709551615, false},
+		{"10.5", 0, true},
+		{"0.00000000000000000001", 0, true},
+		{"-1", 0, true},
+		{"18446744073709551616", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := MustParse(tt.in).Uint64()
+		if tt.wantErr != (nil != err) {
+			t.Fatalf("Uint64(%s) err = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if !tt.wantErr && tt.want != got {
+			t.Fatalf("Uint64(%s) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    string
+		wantErr bool
+	}{
+		{"decimal 列", []byte("12.34000000000000000000"), "12.34", false},
+		{"字符串", "-5", "-5", false},
+		{"整数", int64(3), "3", false},
+		{"NULL", nil, "0", false},
+		{"格式错误", "1..2", "", true},
+		{"不支持的类型", true, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Money
+			err := got.Scan(tt.src)
+			if tt.wantErr != (nil != err) {
+				t.Fatalf("Scan(%v) err = %v, wantErr %v", tt.src, err, tt.wantErr)
+			}
+			if !tt.wantErr && tt.want != got.String() {
+				t.Fatalf("Scan(%v) = %s, want %s", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	b, err := json.Marshal(MustParse("1.25"))
+	if nil != err || `"1.25"` != string(b) {
+		t.Fatalf("Marshal = %s, %v", b, err)
+	}
+
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{`"1.25"`, "1.25", false},
+		{`3.5`, "3.5", false},
+		{`"-"`, "", true},
+		{`"."`, "", true},
+	}
+
+	for _, tt := range tests {
+		var got Money
+		err = json.Unmarshal([]byte(tt.in), &got)
+		if tt.wantErr != (nil != err) {
+			t.Fatalf("Unmarshal(%s) err = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if !tt.wantErr && tt.want != got.String() {
+			t.Fatalf("Unmarshal(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
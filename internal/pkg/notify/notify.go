@@ -0,0 +1,80 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Notifier 告警通知，实现可替换，发送失败不影响调用方的业务
+type Notifier interface {
+	Notify(ctx context.Context, title string, text string) error
+}
+
+// Log 只打印，未配置通知渠道时使用
+type Log struct{}
+
+func (Log) Notify(ctx context.Context, title string, text string) error {
+	fmt.Println("告警", title, text)
+	return nil
+}
+
+// webhookRequest POST 到 webhook 的内容
+type webhookRequest struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+// Webhook POST json 到配置的地址，2xx 视为成功
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhook(url string, timeout time.Duration) (Notifier, error) {
+	if "" == url {
+		return nil, fmt.Errorf("notify: webhook url empty")
+	}
+
+	if 0 >= timeout {
+		timeout = 10 * time.Second
+	}
+
+	return &Webhook{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (w *Webhook) Notify(ctx context.Context, title string, text string) error {
+	body, err := json.Marshal(&webhookRequest{
+		Title: title,
+		Text:  text,
+	})
+	if nil != err {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if nil != err {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if nil != err {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if 200 > resp.StatusCode || 300 <= resp.StatusCode {
+		tmp, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("notify: webhook status %d %s", resp.StatusCode, string(tmp))
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package rpcpool
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// Client 业务用到的链上方法，ethclient.Client 和 backends.SimulatedBackend 都满足
+type Client interface {
+	bind.ContractBackend
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Backend 链上调用入口，节点池和单个客户端都实现
+type Backend interface {
+	Do(ctx context.Context, fn func(ctx context.Context, client Client) error) error
+}
+
+// Static 固定使用一个客户端，不做重试
+type Static struct {
+	client Client
+}
+
+func NewStatic(client Client) *Static {
+	return &Static{client: client}
+}
+
+func (s *Static) Do(ctx context.Context, fn func(ctx context.Context, client Client) error) error {
+	return fn(ctx, s.client)
+}
@@ -0,0 +1,315 @@
+package rpcpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"sort"
+	"sync"
+	"time"
+)
+
+var ErrNoEndpoint = errors.New("rpcpool: no healthy endpoint")
+
+type endpointKey struct{}
+
+// Endpoint Do 回调内当前使用的节点地址
+func Endpoint(ctx context.Context) string {
+	url, _ := ctx.Value(endpointKey{}).(string)
+	return url
+}
+
+// Config 节点池配置
+type Config struct {
+	Endpoints     []string
+	Timeout       time.Duration // 单次调用超时
+	MaxFailures   uint32        // 连续失败多少次熔断
+	Cooldown      time.Duration // 熔断时长
+	MaxLag        uint64        // 允许落后最高区块的块数
+	CheckInterval time.Duration // 区块高度检查间隔
+	Retries       uint32        // Do 最多尝试的节点数
+}
+
+// Stat 节点健康状态
+type Stat struct {
+	Url       string
+	Latency   time.Duration
+	ErrorRate float64
+	Height    uint64
+	Lag       uint64
+	Open      bool
+}
+
+type endpoint struct {
+	url         string
+	client      *ethclient.Client
+	latency     time.Duration // 平滑延迟
+	errorRate   float64       // 平滑错误率
+	consecutive uint32
+	openUntil   time.Time
+	height      uint64
+}
+
+// Pool 按延迟、错误率、区块落后程度选择节点，连续失败的节点熔断一段时间
+type Pool struct {
+	c Config
+
+	mu        sync.Mutex
+	endpoints []*endpoint
+	maxHeight uint64
+	lastCheck time.Time
+}
+
+func New(c Config) *Pool {
+	if 0 >= c.Timeout {
+		c.Timeout = 10 * time.Second
+	}
+	if 0 >= c.MaxFailures {
+		c.MaxFailures = 3
+	}
+	if 0 >= c.Cooldown {
+		c.Cooldown = 30 * time.Second
+	}
+	if 0 >= c.MaxLag {
+		c.MaxLag = 10
+	}
+	if 0 >= c.CheckInterval {
+		c.CheckInterval = 15 * time.Second
+	}
+	if 0 >= c.Retries {
+		c.Retries = uint32(len(c.Endpoints))
+	}
+
+	p := &Pool{c: c}
+	for _, v := range c.Endpoints {
+		p.endpoints = append(p.endpoints, &endpoint{url: v})
+	}
+
+	return p
+}
+
+// Do 用当前最健康的节点执行 fn，失败后换下一个节点，最多 Retries 次
+func (p *Pool) Do(ctx context.Context, fn func(ctx context.Context, client Client) error) error {
+	p.checkHeights(ctx)
+
+	var (
+		tried = make(map[*endpoint]bool, 0)
+		err   = ErrNoEndpoint
+	)
+	for i := uint32(0); i < p.c.Retries; i++ {
+		e := p.pick(tried)
+		if nil == e {
+			break
+		}
+		tried[e] = true
+
+		var client *ethclient.Client
+		client, err = p.dial(e)
+		if nil != err {
+			p.report(e, 0, err)
+			continue
+		}
+
+		callCtx, cancel := context.WithTimeout(context.WithValue(ctx, endpointKey{}, e.url), p.c.Timeout)
+		start := time.Now()
+		err = fn(callCtx, client)
+		cancel()
+		p.report(e, time.Since(start), err)
+		if nil == err {
+			return nil
+		}
+
+		if nil != ctx.Err() {
+			return ctx.Err()
+		}
+	}
+
+	return err
+}
+
+// Stats 各节点当前状态
+func (p *Pool) Stats() []*Stat {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res := make([]*Stat, 0, len(p.endpoints))
+	now := time.Now()
+	for _, e := range p.endpoints {
+		res = append(res, &Stat{
+			Url:       e.url,
+			Latency:   e.latency,
+			ErrorRate: e.errorRate,
+			Height:    e.height,
+			Lag:       p.lag(e),
+			Open:      now.Before(e.openUntil),
+		})
+	}
+
+	return res
+}
+
+func (p *Pool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, e := range p.endpoints {
+		if nil != e.client {
+			e.client.Close()
+			e.client = nil
+		}
+	}
+}
+
+func (p *Pool) dial(e *endpoint) (*ethclient.Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if nil != e.client {
+		return e.client, nil
+	}
+
+	client, err := ethclient.Dial(e.url)
+	if nil != err {
+		return nil, fmt.Errorf("dial %s: %w", e.url, err)
+	}
+
+	e.client = client
+	return client, nil
+}
+
+func (p *Pool) lag(e *endpoint) uint64 {
+	if 0 == e.height || e.height >= p.maxHeight {
+		return 0
+	}
+
+	return p.maxHeight - e.height
+}
+
+// score 越小越好，错误率放大延迟
+func (p *Pool) score(e *endpoint) float64 {
+	latency := float64(e.latency)
+	if 0 >= latency {
+		latency = float64(p.c.Timeout) / 10
+	}
+
+	return latency * (1 + 10*e.errorRate)
+}
+
+func (p *Pool) pick(tried map[*endpoint]bool) *endpoint {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	candidates := make([]*endpoint, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		if tried[e] || now.Before(e.openUntil) {
+			continue
+		}
+
+		if p.lag(e) > p.c.MaxLag {
+			continue
+		}
+
+		candidates = append(candidates, e)
+	}
+
+	// 全部不可用时退而求其次，取熔断最早结束的
+	if 0 >= len(candidates) {
+		for _, e := range p.endpoints {
+			if tried[e] {
+				continue
+			}
+			candidates = append(candidates, e)
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].openUntil.Before(candidates[j].openUntil)
+		})
+	} else {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return p.score(candidates[i]) < p.score(candidates[j])
+		})
+	}
+
+	if 0 >= len(candidates) {
+		return nil
+	}
+
+	return candidates[0]
+}
+
+func (p *Pool) report(e *endpoint, latency time.Duration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if nil == err {
+		if 0 >= e.latency {
+			e.latency = latency
+		} else {
+			e.latency = (e.latency*4 + latency) / 5
+		}
+		e.errorRate = e.errorRate * 0.8
+		e.consecutive = 0
+		return
+	}
+
+	fmt.Println("rpc节点错误", e.url, err)
+	e.errorRate = e.errorRate*0.8 + 0.2
+	e.consecutive++
+	// 熔断期间只是不再选这个节点，客户端可能还在其他调用中使用，不关闭，Close 时统一关闭
+	if e.consecutive >= p.c.MaxFailures {
+		e.openUntil = time.Now().Add(p.c.Cooldown)
+		e.consecutive = 0
+	}
+}
+
+// checkHeights 定期查询各节点区块高度，计算落后块数
+func (p *Pool) checkHeights(ctx context.Context) {
+	p.mu.Lock()
+	if time.Since(p.lastCheck) < p.c.CheckInterval {
+		p.mu.Unlock()
+		return
+	}
+	p.lastCheck = time.Now()
+	endpoints := make([]*endpoint, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		if p.lastCheck.Before(e.openUntil) {
+			continue
+		}
+		endpoints = append(endpoints, e)
+	}
+	p.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, e := range endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+
+			client, err := p.dial(e)
+			if nil != err {
+				p.report(e, 0, err)
+				return
+			}
+
+			callCtx, cancel := context.WithTimeout(ctx, p.c.Timeout)
+			defer cancel()
+
+			start := time.Now()
+			height, err := client.BlockNumber(callCtx)
+			p.report(e, time.Since(start), err)
+			if nil != err {
+				return
+			}
+
+			p.mu.Lock()
+			e.height = height
+			if height > p.maxHeight {
+				p.maxHeight = height
+			}
+			p.mu.Unlock()
+		}(e)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,205 @@
+package rpcpool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testNode 只实现 eth_blockNumber 的节点
+type testNode struct {
+	*httptest.Server
+	height atomic.Uint64
+}
+
+func newTestNode(t *testing.T, height uint64) *testNode {
+	t.Helper()
+
+	n := &testNode{}
+	n.height.Store(height)
+	n.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); nil != err {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		res := map[string]interface{}{"jsonrpc": "2.0", "id": req.Id}
+		if "eth_blockNumber" == req.Method {
+			res["result"] = fmt.Sprintf("0x%x", n.height.Load())
+		} else {
+			res["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(n.Close)
+
+	return n
+}
+
+func newTestPool(t *testing.T, c Config, nodes ...*testNode) *Pool {
+	t.Helper()
+
+	for _, v := range nodes {
+		c.Endpoints = append(c.Endpoints, v.URL)
+	}
+	if 0 >= c.CheckInterval {
+		c.CheckInterval = time.Hour
+	}
+
+	p := New(c)
+	t.Cleanup(p.Close)
+	return p
+}
+
+// used Do 实际使用的节点
+func used(t *testing.T, p *Pool, fail map[string]bool) string {
+	t.Helper()
+
+	var url string
+	_ = p.Do(context.Background(), func(ctx context.Context, client Client) error {
+		url = Endpoint(ctx)
+		if fail[url] {
+			return fmt.Errorf("fail %s", url)
+		}
+		return nil
+	})
+
+	return url
+}
+
+func stat(p *Pool, url string) *Stat {
+	for _, v := range p.Stats() {
+		if url == v.Url {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func TestPoolScore(t *testing.T) {
+	a, b := newTestNode(t, 100), newTestNode(t, 100)
+	p := newTestPool(t, Config{MaxFailures: 100}, a, b)
+
+	// 延迟低的优先
+	p.report(p.endpoints[0], 200*time.Millisecond, nil)
+	p.report(p.endpoints[1], 50*time.Millisecond, nil)
+	if got := used(t, p, nil); b.URL != got {
+		t.Fatalf("used = %s, want faster %s", got, b.URL)
+	}
+
+	// 错误率放大延迟，出错多的即使更快也排在后面
+	for i := 0; i < 5; i++ {
+		p.report(p.endpoints[1], 50*time.Millisecond, fmt.Errorf("fail"))
+	}
+	if got := used(t, p, nil); a.URL != got {
+		t.Fatalf("used = %s, want reliable %s", got, a.URL)
+	}
+}
+
+func TestPoolEjectsAfterMaxFailures(t *testing.T) {
+	a, b := newTestNode(t, 100), newTestNode(t, 100)
+	p := newTestPool(t, Config{MaxFailures: 2, Cooldown: time.Hour, Retries: 1}, a, b)
+	p.report(p.endpoints[0], time.Millisecond, nil)
+	p.report(p.endpoints[1], time.Second, nil)
+
+	// 其他调用还在用熔断节点的客户端
+	var held Client
+	_ = p.Do(context.Background(), func(ctx context.Context, client Client) error {
+		held = client
+		return nil
+	})
+
+	fail := map[string]bool{a.URL: true}
+	for i := 0; i < 2; i++ {
+		if got := used(t, p, fail); a.URL != got {
+			t.Fatalf("call %d used = %s, want %s", i, got, a.URL)
+		}
+	}
+
+	if s := stat(p, a.URL); nil == s || !s.Open {
+		t.Fatalf("stat = %+v, want open", s)
+	}
+	if got := used(t, p, fail); b.URL != got {
+		t.Fatalf("used = %s, want %s", got, b.URL)
+	}
+
+	// 熔断不关闭客户端
+	if _, err := held.(interface {
+		BlockNumber(ctx context.Context) (uint64, error)
+	}).BlockNumber(context.Background()); nil != err {
+		t.Fatalf("held client after eject: %v", err)
+	}
+}
+
+func TestPoolCooldownRecovery(t *testing.T) {
+	a, b := newTestNode(t, 100), newTestNode(t, 100)
+	p := newTestPool(t, Config{MaxFailures: 1, Cooldown: 50 * time.Millisecond, Retries: 1}, a, b)
+	p.report(p.endpoints[0], time.Millisecond, nil)
+	p.report(p.endpoints[1], time.Second, nil)
+
+	if got := used(t, p, map[string]bool{a.URL: true}); a.URL != got {
+		t.Fatalf("used = %s, want %s", got, a.URL)
+	}
+	if got := used(t, p, nil); b.URL != got {
+		t.Fatalf("used = %s during cooldown, want %s", got, b.URL)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if s := stat(p, a.URL); nil == s || s.Open {
+		t.Fatalf("stat = %+v, want closed", s)
+	}
+	if got := used(t, p, nil); a.URL != got {
+		t.Fatalf("used = %s after cooldown, want %s", got, a.URL)
+	}
+}
+
+func TestPoolAllOpenFallsBack(t *testing.T) {
+	a, b := newTestNode(t, 100), newTestNode(t, 100)
+	p := newTestPool(t, Config{MaxFailures: 1, Cooldown: time.Hour}, a, b)
+
+	// 全部熔断时仍尝试熔断最早结束的节点
+	tried := make([]string, 0)
+	err := p.Do(context.Background(), func(ctx context.Context, client Client) error {
+		tried = append(tried, Endpoint(ctx))
+		return fmt.Errorf("fail")
+	})
+	if nil == err || 2 != len(tried) {
+		t.Fatalf("Do() = %v, tried %v", err, tried)
+	}
+	if got := used(t, p, nil); tried[0] != got {
+		t.Fatalf("used = %s, want earliest opened %s", got, tried[0])
+	}
+}
+
+func TestPoolMaxLag(t *testing.T) {
+	a, b := newTestNode(t, 80), newTestNode(t, 100)
+	p := newTestPool(t, Config{MaxLag: 10, CheckInterval: time.Nanosecond}, a, b)
+	p.report(p.endpoints[0], time.Millisecond, nil)
+	p.report(p.endpoints[1], time.Second, nil)
+
+	// 更快的节点落后太多不选
+	if got := used(t, p, nil); b.URL != got {
+		t.Fatalf("used = %s, want %s", got, b.URL)
+	}
+	if s := stat(p, a.URL); nil == s || 20 != s.Lag || 80 != s.Height {
+		t.Fatalf("stat = %+v, want lag 20", s)
+	}
+
+	// 追上后恢复
+	a.height.Store(95)
+	if got := used(t, p, nil); a.URL != got {
+		t.Fatalf("used = %s, want %s", got, a.URL)
+	}
+}
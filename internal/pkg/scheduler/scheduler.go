@@ -0,0 +1,348 @@
+package scheduler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrJobNotFound = errors.New("scheduler: job not found")
+	ErrJobRunning  = errors.New("scheduler: job is running")
+	ErrStopped     = errors.New("scheduler: stopped")
+)
+
+// 只删除自己持有的租约
+var releaseScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+// Job 定时任务，Interval 为0时只能手动触发
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Timeout  time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Status 任务最近一次执行情况
+type Status struct {
+	Name       string
+	Interval   time.Duration
+	Timeout    time.Duration
+	Running    bool
+	LastStart  time.Time
+	LastEnd    time.Time
+	LastResult string // ok 成功，error 失败，skipped 其他实例持有租约
+	LastError  string
+	RunCount   uint64
+}
+
+type job struct {
+	Job
+
+	running bool
+	status  Status
+}
+
+// Scheduler 进程内定时任务，同一任务不重叠执行，多实例之间用 Redis 租约互斥
+type Scheduler struct {
+	rdb    *redis.Client
+	prefix string
+
+	mu     sync.Mutex
+	jobs   map[string]*job
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New(rdb *redis.Client, prefix string) *Scheduler {
+	if "" == prefix {
+		prefix = "job:"
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		rdb:    rdb,
+		prefix: prefix,
+		jobs:   make(map[string]*job, 0),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Register 注册任务，需在 Start 之前调用
+func (s *Scheduler) Register(j Job) {
+	if 0 >= j.Timeout {
+		j.Timeout = 60 * time.Second
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobs[j.Name] = &job{
+		Job: j,
+		status: Status{
+			Name:     j.Name,
+			Interval: j.Interval,
+			Timeout:  j.Timeout,
+		},
+	}
+}
+
+// Start 实现 transport.Server，随 kratos 应用启动
+func (s *Scheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, j := range s.jobs {
+		if 0 >= j.Interval {
+			continue
+		}
+
+		s.wg.Add(1)
+		go s.loop(j)
+	}
+
+	return nil
+}
+
+// Stop 实现 transport.Server，等待执行中的任务结束，之后不再接受 Trigger
+func (s *Scheduler) Stop(ctx context.Context) error {
+	// 和 Trigger 的 wg.Add 在同一把锁下，取消之后不会再有新的 Add
+	s.mu.Lock()
+	s.cancel()
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Trigger 手动执行一次，拿到租约后返回，不等待结果；本实例或其他实例正在执行时返回 ErrJobRunning
+func (s *Scheduler) Trigger(name string) error {
+	s.mu.Lock()
+	j, ok := s.jobs[name]
+	if !ok {
+		s.mu.Unlock()
+		return ErrJobNotFound
+	}
+	if nil != s.ctx.Err() {
+		s.mu.Unlock()
+		return ErrStopped
+	}
+	if !s.claim(j) {
+		s.mu.Unlock()
+		return ErrJobRunning
+	}
+	s.wg.Add(1)
+	s.mu.Unlock()
+
+	token, err := s.lease(j)
+	if nil != err {
+		s.wg.Done()
+		return err
+	}
+
+	go func() {
+		defer s.wg.Done()
+		s.exec(j, token)
+	}()
+
+	return nil
+}
+
+// Statuses 按名称排序的任务状态
+func (s *Scheduler) Statuses() []*Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]*Status, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		tmp := j.status
+		tmp.Running = j.running
+		res = append(res, &tmp)
+	}
+
+	sort.Slice(res, func(i, k int) bool {
+		return res[i].Name < res[k].Name
+	})
+
+	return res
+}
+
+func (s *Scheduler) loop(j *job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+
+	s.run(j)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.run(j)
+		}
+	}
+}
+
+// run 执行一次，上一次未结束或其他实例持有租约时跳过
+func (s *Scheduler) run(j *job) {
+	s.mu.Lock()
+	claimed := s.claim(j)
+	s.mu.Unlock()
+	if !claimed {
+		return
+	}
+
+	token, err := s.lease(j)
+	if nil != err {
+		return
+	}
+
+	s.exec(j, token)
+}
+
+// claim 标记为执行中，调用方持有 s.mu
+func (s *Scheduler) claim(j *job) bool {
+	if j.running {
+		return false
+	}
+
+	j.running = true
+	return true
+}
+
+func (s *Scheduler) unclaim(j *job) {
+	s.mu.Lock()
+	j.running = false
+	s.mu.Unlock()
+}
+
+// lease 已 claim 的任务取租约，失败或被其他实例持有时记录结果并取消 claim
+func (s *Scheduler) lease(j *job) (string, error) {
+	// 租约比超时多留一些，任务超时前不会被其他实例拿走
+	token, ok, err := s.acquire(j.Name, j.Timeout+10*time.Second)
+	if nil != err {
+		s.finish(j, time.Now(), "error", err)
+		s.unclaim(j)
+		return "", err
+	}
+	if !ok {
+		s.finish(j, time.Now(), "skipped", nil)
+		s.unclaim(j)
+		return "", ErrJobRunning
+	}
+
+	return token, nil
+}
+
+// exec 执行已 claim 且拿到租约的任务
+func (s *Scheduler) exec(j *job, token string) {
+	defer s.unclaim(j)
+	defer s.release(j.Name, token)
+
+	ctx, cancel := context.WithTimeout(s.ctx, j.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	s.mu.Lock()
+	j.status.LastStart = start
+	s.mu.Unlock()
+
+	err := s.call(ctx, j)
+	if nil != err {
+		fmt.Println("定时任务失败", j.Name, err)
+		s.finish(j, start, "error", err)
+		return
+	}
+
+	s.finish(j, start, "ok", nil)
+}
+
+func (s *Scheduler) call(ctx context.Context, j *job) (err error) {
+	defer func() {
+		if r := recover(); nil != r {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return j.Run(ctx)
+}
+
+func (s *Scheduler) finish(j *job, start time.Time, result string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j.status.LastResult = result
+	j.status.LastError = ""
+	if nil != err {
+		j.status.LastError = err.Error()
+	}
+
+	// 跳过的不算执行
+	if "skipped" == result {
+		return
+	}
+
+	j.status.LastStart = start
+	j.status.LastEnd = time.Now()
+	j.status.RunCount++
+}
+
+func (s *Scheduler) acquire(name string, ttl time.Duration) (string, bool, error) {
+	if nil == s.rdb {
+		return "", true, nil
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); nil != err {
+		return "", false, err
+	}
+	token := hex.EncodeToString(b)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 5*time.Second)
+	defer cancel()
+
+	ok, err := s.rdb.SetNX(ctx, s.prefix+name, token, ttl).Result()
+	if nil != err {
+		return "", false, err
+	}
+
+	return token, ok, nil
+}
+
+func (s *Scheduler) release(name string, token string) {
+	if nil == s.rdb {
+		return
+	}
+
+	// 停机时 s.ctx 已取消，释放用独立的 context
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := releaseScript.Run(ctx, s.rdb, []string{s.prefix + name}, token).Err(); nil != err && redis.Nil != err {
+		fmt.Println("释放任务租约失败", name, err)
+	}
+}
@@ -0,0 +1,191 @@
+package scheduler
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	return mr, rdb
+}
+
+// blockingJob 执行后阻塞到 release 关闭
+func blockingJob(name string) (Job, chan struct{}, chan struct{}) {
+	started, release := make(chan struct{}, 10), make(chan struct{})
+	return Job{
+		Name:    name,
+		Timeout: 5 * time.Second,
+		Run: func(ctx context.Context) error {
+			started <- struct{}{}
+			<-release
+			return nil
+		},
+	}, started, release
+}
+
+func status(s *Scheduler, name string) *Status {
+	for _, v := range s.Statuses() {
+		if name == v.Name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// waitIdle 等任务结束
+func waitIdle(t *testing.T, s *Scheduler, name string) *Status {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if v := status(s, name); nil != v && !v.Running {
+			return v
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	t.Fatalf("job %s still running", name)
+	return nil
+}
+
+func TestTriggerNoOverlap(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	s := New(rdb, "test:")
+	j, started, release := blockingJob("a")
+	s.Register(j)
+
+	if err := s.Trigger("a"); nil != err {
+		t.Fatal(err)
+	}
+	<-started
+
+	// 执行中再次触发和定时触发都不执行
+	if err := s.Trigger("a"); ErrJobRunning != err {
+		t.Fatalf("Trigger() = %v, want ErrJobRunning", err)
+	}
+	s.run(s.jobs["a"])
+	if 0 != len(started) {
+		t.Fatal("job overlapped")
+	}
+
+	close(release)
+	if v := waitIdle(t, s, "a"); "ok" != v.LastResult || 1 != v.RunCount {
+		t.Fatalf("status = %+v", v)
+	}
+
+	if err := s.Trigger("missing"); ErrJobNotFound != err {
+		t.Fatalf("Trigger() = %v, want ErrJobNotFound", err)
+	}
+}
+
+func TestTriggerLeaseHeldByOtherInstance(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	a, b := New(rdb, "test:"), New(rdb, "test:")
+	j, started, release := blockingJob("a")
+	a.Register(j)
+	b.Register(Job{Name: "a", Timeout: 5 * time.Second, Run: func(ctx context.Context) error {
+		t.Error("job ran on both instances")
+		return nil
+	}})
+
+	if err := a.Trigger("a"); nil != err {
+		t.Fatal(err)
+	}
+	<-started
+
+	// 租约时长为超时加 10 秒
+	if ttl := mr.TTL("test:a"); 15*time.Second != ttl {
+		t.Fatalf("lease ttl = %s", ttl)
+	}
+
+	// 其他实例持有租约时手动触发直接返回执行中
+	if err := b.Trigger("a"); ErrJobRunning != err {
+		t.Fatalf("Trigger() = %v, want ErrJobRunning", err)
+	}
+	if v := status(b, "a"); "skipped" != v.LastResult || v.Running || 0 != v.RunCount {
+		t.Fatalf("status = %+v", v)
+	}
+
+	close(release)
+	waitIdle(t, a, "a")
+	if mr.Exists("test:a") {
+		t.Fatal("lease not released")
+	}
+}
+
+func TestReleaseOnlyOwnLease(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	s := New(rdb, "test:")
+
+	token, ok, err := s.acquire("a", time.Second)
+	if nil != err || !ok {
+		t.Fatalf("acquire() = %v, %v", ok, err)
+	}
+
+	// 租约过期后被其他实例拿走，不能删掉别人的
+	mr.FastForward(2 * time.Second)
+	if err = mr.Set("test:a", "other"); nil != err {
+		t.Fatal(err)
+	}
+	s.release("a", token)
+
+	if got, _ := mr.Get("test:a"); "other" != got {
+		t.Fatalf("lease = %q, want other", got)
+	}
+}
+
+func TestRunRecoversPanic(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	s := New(rdb, "test:")
+	s.Register(Job{Name: "a", Run: func(ctx context.Context) error {
+		panic("boom")
+	}})
+
+	s.run(s.jobs["a"])
+	if v := status(s, "a"); "error" != v.LastResult || "panic: boom" != v.LastError || v.Running {
+		t.Fatalf("status = %+v", v)
+	}
+}
+
+func TestTriggerAfterStop(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	s := New(rdb, "test:")
+	s.Register(Job{Name: "a", Run: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+
+	// 和 Stop 并发触发，Stop 开始后不再 Add
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = s.Trigger("a")
+		}()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.Stop(ctx); nil != err {
+		t.Fatal(err)
+	}
+	wg.Wait()
+
+	if err := s.Trigger("a"); ErrStopped != err {
+		t.Fatalf("Trigger() = %v, want ErrStopped", err)
+	}
+	if err := s.Stop(ctx); nil != err {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,39 @@
+package signer
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"os"
+	"strings"
+)
+
+// NewKeystore 加密的 keystore 文件，密码来自配置或环境变量
+func NewKeystore(path string, passphrase string) (Signer, error) {
+	keyJson, err := os.ReadFile(path)
+	if nil != err {
+		return nil, fmt.Errorf("signer: read keystore: %w", err)
+	}
+
+	key, err := keystore.DecryptKey(keyJson, passphrase)
+	if nil != err {
+		return nil, fmt.Errorf("signer: decrypt keystore: %w", err)
+	}
+
+	return &keySigner{key: key.PrivateKey, address: key.Address}, nil
+}
+
+// NewEnv 环境变量中的十六进制私钥，仅用于开发环境
+func NewEnv(name string) (Signer, error) {
+	raw := strings.TrimPrefix(strings.TrimSpace(os.Getenv(name)), "0x")
+	if "" == raw {
+		return nil, fmt.Errorf("signer: env %s is empty", name)
+	}
+
+	key, err := crypto.HexToECDSA(raw)
+	if nil != err {
+		return nil, fmt.Errorf("signer: parse key from env %s: %w", name, err)
+	}
+
+	return &keySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}, nil
+}
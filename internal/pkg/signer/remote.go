@@ -0,0 +1,129 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+)
+
+// remoteRequest POST 到签名服务的内容，tx 为未签名交易的 MarshalBinary
+type remoteRequest struct {
+	ChainId string `json:"chainId"`
+	From    string `json:"from"`
+	Tx      string `json:"tx"`
+}
+
+// remoteReply 签名服务返回已签名交易的 MarshalBinary
+type remoteReply struct {
+	SignedTx string `json:"signedTx"`
+	Error    string `json:"error"`
+}
+
+// Remote 远程签名服务，本地可以用一个按同样协议返回的桩服务代替
+type Remote struct {
+	url     string
+	token   string
+	address common.Address
+	client  *http.Client
+}
+
+func NewRemote(url string, address string, token string, timeout time.Duration) (Signer, error) {
+	if "" == url || !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("signer: remote url or address invalid")
+	}
+
+	if 0 >= timeout {
+		timeout = 10 * time.Second
+	}
+
+	return &Remote{
+		url:     url,
+		token:   token,
+		address: common.HexToAddress(address),
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (r *Remote) Address() common.Address {
+	return r.address
+}
+
+func (r *Remote) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	raw, err := tx.MarshalBinary()
+	if nil != err {
+		return nil, err
+	}
+
+	body, err := json.Marshal(&remoteRequest{
+		ChainId: chainId.String(),
+		From:    r.address.Hex(),
+		Tx:      hexutil.Encode(raw),
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(body))
+	if nil != err {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if "" != r.token {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	resp, err := r.client.Do(req)
+	if nil != err {
+		return nil, fmt.Errorf("signer: remote: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if nil != err {
+		return nil, err
+	}
+
+	var reply remoteReply
+	if err = json.Unmarshal(respBody, &reply); nil != err {
+		return nil, fmt.Errorf("signer: remote status %d: %w", resp.StatusCode, err)
+	}
+
+	if http.StatusOK != resp.StatusCode || "" != reply.Error {
+		return nil, fmt.Errorf("signer: remote status %d: %s", resp.StatusCode, reply.Error)
+	}
+
+	signedRaw, err := hexutil.Decode(reply.SignedTx)
+	if nil != err {
+		return nil, err
+	}
+
+	signed := new(types.Transaction)
+	if err = signed.UnmarshalBinary(signedRaw); nil != err {
+		return nil, err
+	}
+
+	// 签名服务不能替换交易内容或用别的地址签
+	if signed.Nonce() != tx.Nonce() || signed.Value().Cmp(tx.Value()) != 0 ||
+		!bytes.Equal(signed.Data(), tx.Data()) || nil == signed.To() || nil == tx.To() || *signed.To() != *tx.To() {
+		return nil, fmt.Errorf("signer: remote returned a different transaction")
+	}
+
+	from, err := types.Sender(types.LatestSignerForChainID(chainId), signed)
+	if nil != err {
+		return nil, err
+	}
+
+	if from != r.address {
+		return nil, ErrAddressMismatch
+	}
+
+	return signed, nil
+}
@@ -0,0 +1,72 @@
+package signer
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newStubServer(t *testing.T, token string) (Signer, *httptest.Server) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	t.Setenv("TEST_STUB_KEY", hexutil.Encode(crypto.FromECDSA(key)))
+	local, err := NewEnv("TEST_STUB_KEY")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(NewRemoteStub(local, token))
+	t.Cleanup(server.Close)
+	return local, server
+}
+
+func TestRemoteWithStub(t *testing.T) {
+	ctx := context.Background()
+	chainId := big.NewInt(56)
+	local, server := newStubServer(t, "secret")
+
+	remote, err := NewRemote(server.URL, local.Address().Hex(), "secret", time.Second)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if err = Check(ctx, remote, chainId); nil != err {
+		t.Fatalf("Check() = %v", err)
+	}
+
+	// token 错误
+	remote, _ = NewRemote(server.URL, local.Address().Hex(), "wrong", time.Second)
+	if err = Check(ctx, remote, chainId); nil == err {
+		t.Fatal("Check() with wrong token should fail")
+	}
+
+	// 地址和桩服务的私钥不一致
+	other, _ := crypto.GenerateKey()
+	remote, _ = NewRemote(server.URL, crypto.PubkeyToAddress(other.PublicKey).Hex(), "secret", time.Second)
+	if err = Check(ctx, remote, chainId); nil == err {
+		t.Fatal("Check() with another address should fail")
+	}
+}
+
+func TestCheckUnreachable(t *testing.T) {
+	local, server := newStubServer(t, "")
+	server.Close()
+
+	remote, err := NewRemote(server.URL, local.Address().Hex(), "", time.Second)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if err = Check(context.Background(), remote, big.NewInt(56)); nil == err {
+		t.Fatal("Check() with closed server should fail")
+	}
+}
@@ -0,0 +1,69 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+var ErrAddressMismatch = errors.New("signer: address mismatch")
+
+// Signer 热钱包签名，私钥不出现在代码和配置明文里
+type Signer interface {
+	Address() common.Address
+	SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error)
+}
+
+// TransactOpts 合约绑定调用使用的交易参数
+func TransactOpts(ctx context.Context, s Signer, chainId *big.Int) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:    s.Address(),
+		Context: ctx,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != s.Address() {
+				return nil, ErrAddressMismatch
+			}
+
+			return s.SignTx(ctx, tx, chainId)
+		},
+	}
+}
+
+// keySigner 本地私钥签名，keystore 和环境变量两种方式共用
+type keySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+func (k *keySigner) Address() common.Address {
+	return k.address
+}
+
+func (k *keySigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainId), k.key)
+}
+
+// Check 签一笔不广播的空交易，确认签名可用且签名地址一致，启动时调用
+func Check(ctx context.Context, s Signer, chainId *big.Int) error {
+	to := s.Address()
+	tx := types.NewTx(&types.LegacyTx{To: &to, Gas: 21000, GasPrice: new(big.Int), Value: new(big.Int)})
+	signed, err := s.SignTx(ctx, tx, chainId)
+	if nil != err {
+		return err
+	}
+
+	from, err := types.Sender(types.LatestSignerForChainID(chainId), signed)
+	if nil != err {
+		return err
+	}
+
+	if from != s.Address() {
+		return ErrAddressMismatch
+	}
+
+	return nil
+}
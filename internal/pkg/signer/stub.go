@@ -0,0 +1,88 @@
+package signer
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"net/http"
+)
+
+// RemoteStub 本地开发用的签名服务桩，协议和 Remote 一致，用本地私钥签名，不能用于生产
+type RemoteStub struct {
+	s     Signer
+	token string
+}
+
+// NewRemoteStub token 为空时不校验 Authorization
+func NewRemoteStub(s Signer, token string) *RemoteStub {
+	return &RemoteStub{s: s, token: token}
+}
+
+func (r *RemoteStub) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if http.MethodPost != req.Method {
+		r.reply(w, http.StatusMethodNotAllowed, nil, "method not allowed")
+		return
+	}
+
+	if "" != r.token && "Bearer "+r.token != req.Header.Get("Authorization") {
+		r.reply(w, http.StatusUnauthorized, nil, "unauthorized")
+		return
+	}
+
+	var body remoteRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); nil != err {
+		r.reply(w, http.StatusBadRequest, nil, err.Error())
+		return
+	}
+
+	if !common.IsHexAddress(body.From) || common.HexToAddress(body.From) != r.s.Address() {
+		r.reply(w, http.StatusBadRequest, nil, "unknown from "+body.From)
+		return
+	}
+
+	chainId, ok := new(big.Int).SetString(body.ChainId, 10)
+	if !ok {
+		r.reply(w, http.StatusBadRequest, nil, "invalid chainId "+body.ChainId)
+		return
+	}
+
+	raw, err := hexutil.Decode(body.Tx)
+	if nil != err {
+		r.reply(w, http.StatusBadRequest, nil, err.Error())
+		return
+	}
+
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(raw); nil != err {
+		r.reply(w, http.StatusBadRequest, nil, err.Error())
+		return
+	}
+
+	signed, err := r.s.SignTx(req.Context(), tx, chainId)
+	if nil != err {
+		r.reply(w, http.StatusInternalServerError, nil, err.Error())
+		return
+	}
+
+	r.reply(w, http.StatusOK, signed, "")
+}
+
+func (r *RemoteStub) reply(w http.ResponseWriter, status int, signed *types.Transaction, msg string) {
+	res := &remoteReply{Error: msg}
+	if nil != signed {
+		raw, err := signed.MarshalBinary()
+		if nil != err {
+			status = http.StatusInternalServerError
+			res.Error = fmt.Sprintf("marshal: %s", err)
+		} else {
+			res.SignedTx = hexutil.Encode(raw)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
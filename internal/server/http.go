@@ -42,6 +42,8 @@ func NewHTTPServer(c *conf.Server, userService *service.UserService, logger log.
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterUserHTTPServer(srv, userService)
+	// 对账单 CSV 流式导出，token 在 handler 里验
+	srv.HandleFunc("/api/admin_dhb/user_statement_csv", userService.UserStatementCsv)
 
 	//srv.HandleFunc("/api/admin_dhb/callback", userService.CallBack)
 
@@ -0,0 +1,482 @@
+package service
+
+import (
+	"bytes"
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/rpcpool"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"strings"
+)
+
+// buyDeposit 区块中的一笔 buy 充值
+type buyDeposit struct {
+	Hash        string
+	Address     string
+	Amount      *big.Int // 合约原始金额
+	Index       int64    // 合约 users 数组下标
+	BlockNumber uint64
+}
+
+// scannedBlock 扫描结果
+type scannedBlock struct {
+	Number     uint64
+	Hash       string
+	ParentHash string
+	Deposits   []*buyDeposit
+}
+
+// depositScanner 按区块扫描合约的 buy 交易，合约本身不抛充值事件
+type depositScanner struct {
+	source   *DepositSource
+	pool     rpcpool.Backend
+	contract common.Address
+	buy      abi.Method
+	signer   types.Signer
+}
+
+func newDepositScanner(source *DepositSource) (*depositScanner, error) {
+	parsed, err := abi.JSON(strings.NewReader(BuySomethingABI))
+	if err != nil {
+		return nil, err
+	}
+
+	buy, ok := parsed.Methods["buy"]
+	if !ok {
+		return nil, fmt.Errorf("buy method not found")
+	}
+
+	return &depositScanner{
+		source:   source,
+		pool:     source.Pool,
+		contract: common.HexToAddress(source.Conf.Contract),
+		buy:      buy,
+		signer:   types.LatestSignerForChainID(new(big.Int).SetInt64(source.Conf.ChainId)),
+	}, nil
+}
+
+// blockNumber 最新区块高度
+func (s *depositScanner) blockNumber(ctx context.Context) (uint64, error) {
+	var number uint64
+	err := s.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		number = header.Number.Uint64()
+		return nil
+	})
+
+	return number, err
+}
+
+// headerHash 某高度当前链上的区块 hash
+func (s *depositScanner) headerHash(ctx context.Context, number uint64) (string, error) {
+	var header *types.Header
+	err := s.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		var err error
+		header, err = client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return header.Hash().Hex(), nil
+}
+
+// scanBlock 解析一个区块内成功的 buy 交易
+func (s *depositScanner) scanBlock(ctx context.Context, number uint64) (*scannedBlock, error) {
+	var (
+		res *scannedBlock
+	)
+	err := s.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		var err error
+		res, err = s.scanBlockWith(ctx, client, number)
+		return err
+	})
+
+	return res, err
+}
+
+// scanBlockWith 下标、地址和金额以合约状态为准，按区块前后 users 数组长度取本区块新增的充值，
+// 顶层 buy 交易只用来按顺序匹配交易 hash，经其他合约调用的 buy 匹配不到交易，hash 为空
+func (s *depositScanner) scanBlockWith(ctx context.Context, client rpcpool.Client, number uint64) (*scannedBlock, error) {
+	block, err := client.BlockByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &scannedBlock{
+		Number:     number,
+		Hash:       block.Hash().Hex(),
+		ParentHash: block.ParentHash().Hex(),
+		Deposits:   make([]*buyDeposit, 0),
+	}
+
+	instance, err := NewBuySomething(s.contract, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var before, after *big.Int
+	before, err = userLengthAt(ctx, instance, number-1)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err = userLengthAt(ctx, instance, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if 0 <= before.Cmp(after) {
+		return res, nil
+	}
+
+	var (
+		users   []common.Address
+		amounts []*big.Int
+		last    = new(big.Int).Sub(after, big.NewInt(1))
+		opts    = &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(number)}
+	)
+	users, err = instance.GetUsersByIndex(opts, before, last)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts, err = instance.GetUsersAmountByIndex(opts, before, last)
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(users)) != after.Int64()-before.Int64() || len(users) != len(amounts) {
+		return nil, fmt.Errorf("区块 %d 合约充值数量不一致 %d-%d", number, before.Int64(), last.Int64())
+	}
+
+	for k, v := range users {
+		res.Deposits = append(res.Deposits, &buyDeposit{
+			Address:     v.String(),
+			Amount:      new(big.Int).Set(amounts[k]),
+			Index:       before.Int64() + int64(k),
+			BlockNumber: number,
+		})
+	}
+
+	// 顶层 buy 交易按执行顺序对应到合约新增的记录上
+	next := 0
+	for _, tx := range block.Transactions() {
+		if nil == tx.To() || s.contract != *tx.To() {
+			continue
+		}
+
+		data := tx.Data()
+		if 4 > len(data) || !bytes.Equal(data[:4], s.buy.ID) {
+			continue
+		}
+
+		receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+
+		if types.ReceiptStatusSuccessful != receipt.Status {
+			continue
+		}
+
+		args, err := s.buy.Inputs.Unpack(data[4:])
+		if err != nil || 1 != len(args) {
+			fmt.Println("buy参数解析失败", tx.Hash().Hex(), err)
+			continue
+		}
+
+		num, ok := args[0].(*big.Int)
+		if !ok {
+			continue
+		}
+
+		from, err := types.Sender(s.signer, tx)
+		if err != nil {
+			fmt.Println("交易发送者解析失败", tx.Hash().Hex(), err)
+			continue
+		}
+
+		matched := false
+		for ; next < len(res.Deposits); next++ {
+			v := res.Deposits[next]
+			if v.Address == from.String() && 0 == v.Amount.Cmp(num) {
+				v.Hash = tx.Hash().Hex()
+				next++
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			fmt.Println("buy交易未匹配到合约记录", tx.Hash().Hex())
+			break
+		}
+	}
+
+	for _, v := range res.Deposits {
+		if "" == v.Hash {
+			fmt.Println("充值未匹配到交易，按下标入账", number, v.Index, v.Address)
+		}
+	}
+
+	return res, nil
+}
+
+// userLengthAt 某区块结束时 users 数组长度，合约还没部署时为 0
+func userLengthAt(ctx context.Context, instance *BuySomething, number uint64) (*big.Int, error) {
+	res, err := instance.GetUserLength(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(number)})
+	if errors.Is(err, bind.ErrNoCode) {
+		return new(big.Int), nil
+	}
+
+	return res, err
+}
+
+// indexDeposits 扫描已确认区块并入账，返回是否还有未扫描的已确认区块
+func (u *UserService) indexDeposits(ctx context.Context, s *depositScanner) (bool, error) {
+	var (
+		cursor *biz.EthBlockCursor
+		head   uint64
+		err    error
+	)
+
+	confirmations, startBlock, rewind := u.uuc.GetDepositScanConfig()
+	if 0 < s.source.Conf.Confirmations {
+		confirmations = s.source.Conf.Confirmations
+	}
+	if 0 < s.source.Conf.StartBlock {
+		startBlock = s.source.Conf.StartBlock
+	}
+
+	cursor, err = u.uuc.GetEthBlockCursor(s.source.Conf.ChainId, s.source.Conf.Contract)
+	if nil != err {
+		return false, err
+	}
+
+	head, err = s.blockNumber(ctx)
+	if nil != err {
+		return false, err
+	}
+
+	if head <= confirmations {
+		return false, nil
+	}
+	safe := head - confirmations
+
+	if nil == cursor {
+		// 没有游标时从头扫会漏掉旧逻辑之后的充值，必须指定起始区块，已入账的按下标去重
+		if 0 >= startBlock {
+			return false, fmt.Errorf("充值来源 %s 没有扫块游标，需配置 start_block 或 deposit_start_block", s.source.Conf.Name)
+		}
+
+		cursor = &biz.EthBlockCursor{
+			ChainId:     s.source.Conf.ChainId,
+			Contract:    s.source.Conf.Contract,
+			BlockNumber: startBlock - 1,
+		}
+	} else if "" != cursor.BlockHash {
+		// 游标区块已不在主链上，回滚重扫
+		var hash string
+		hash, err = s.headerHash(ctx, cursor.BlockNumber)
+		if nil != err {
+			return false, err
+		}
+
+		if hash != cursor.BlockHash {
+			return true, u.rewindDepositCursor(ctx, s, cursor, rewind)
+		}
+	}
+
+	next := cursor.BlockNumber + 1
+	if next > safe {
+		return false, nil
+	}
+
+	var block *scannedBlock
+	block, err = s.scanBlock(ctx, next)
+	if nil != err {
+		return false, err
+	}
+
+	if "" != cursor.BlockHash && block.ParentHash != cursor.BlockHash {
+		return true, u.rewindDepositCursor(ctx, s, cursor, rewind)
+	}
+
+	// 入账失败不推进游标，下次重扫该区块
+	if 0 < len(block.Deposits) {
+		err = u.creditDeposits(ctx, s.source, block.Deposits)
+		if nil != err {
+			return false, err
+		}
+	}
+
+	cursor.BlockNumber = block.Number
+	cursor.BlockHash = block.Hash
+	err = u.uuc.SaveEthBlockCursor(ctx, cursor)
+	if nil != err {
+		return false, err
+	}
+
+	return cursor.BlockNumber < safe, nil
+}
+
+// rewindDepositCursor 区块重组后游标回退，已入账的交易按 hash 去重
+func (u *UserService) rewindDepositCursor(ctx context.Context, s *depositScanner, cursor *biz.EthBlockCursor, rewind uint64) error {
+	var (
+		hash string
+		err  error
+	)
+
+	if cursor.BlockNumber > rewind {
+		cursor.BlockNumber -= rewind
+	} else {
+		cursor.BlockNumber = 0
+	}
+
+	hash, err = s.headerHash(ctx, cursor.BlockNumber)
+	if nil != err {
+		return err
+	}
+
+	fmt.Println("区块重组，游标回退到", cursor.BlockNumber, hash)
+	cursor.BlockHash = hash
+	return u.uuc.SaveEthBlockCursor(ctx, cursor)
+}
+
+func (u *UserService) creditDeposits(ctx context.Context, source *DepositSource, deposits []*buyDeposit) error {
+	var (
+		depositUsers map[string]*biz.User
+		fromAccount  []string
+		err          error
+	)
+
+	for _, v := range deposits {
+		fromAccount = append(fromAccount, v.Address)
+	}
+
+	depositUsers, err = u.uuc.GetUserByAddress(fromAccount...)
+	if nil != err {
+		return err
+	}
+
+	for _, v := range deposits {
+		if _, ok := depositUsers[v.Address]; !ok { // 用户不存在
+			fmt.Println("充值用户不存在", v.Address, v.Hash)
+			continue
+		}
+
+		wei := source.toWei(v.Amount)
+		record := &biz.EthUserRecord{ // 两种币的记录
+			UserId:       int64(depositUsers[v.Address].ID),
+			Hash:         v.Hash,
+			Amount:       wei.String(),
+			AmountTwo:    biz.ScaleAmount(wei, source.Conf.Decimals, 0).Uint64(),
+			Decimals:     source.Conf.Decimals,
+			Last:         v.Index + 1,
+			BlockNumber:  v.BlockNumber,
+			ChainId:      source.Conf.ChainId,
+			Contract:     source.Conf.Contract,
+			DepositIndex: v.Index,
+		}
+
+		// 小额充值按策略处理，不中断本批次
+		if 0 < source.minWei().Cmp(wei) {
+			fmt.Println("充值金额过小", v.Address, v.Hash, v.Amount.String())
+			err = u.uuc.DepositSmall(ctx, depositUsers[v.Address].ID, v.Address, record, source.minWei())
+			if nil != err {
+				return err
+			}
+			continue
+		}
+
+		// 充值
+		err = u.uuc.DepositNew(ctx, depositUsers[v.Address].ID, record, false)
+		if nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AdminDepositReconcile 充值对账，按来源名称，默认第一个来源
+func (u *UserService) AdminDepositReconcile(ctx context.Context, req *pb.AdminDepositReconcileRequest) (*pb.AdminDepositReconcileReply, error) {
+	var (
+		source     *DepositSource
+		userLength int64
+		onChain    []*biz.DepositOnChain
+		err        error
+	)
+
+	for _, v := range u.sources {
+		if "" == req.Source || req.Source == v.Conf.Name {
+			source = v
+			break
+		}
+	}
+
+	if nil == source {
+		return nil, fmt.Errorf("充值来源不存在 %s", req.Source)
+	}
+
+	userLength, err = getUserLength(ctx, source.Pool, source.Conf.Contract)
+	if nil != err {
+		return nil, err
+	}
+
+	onChain = make([]*biz.DepositOnChain, 0, userLength)
+	for start := int64(0); start < userLength; start += 200 {
+		end := start + 199
+		if end > userLength-1 {
+			end = userLength - 1
+		}
+
+		var tmp []*userDeposit
+		tmp, err = getUserInfo(ctx, source.Pool, start, end, source.Conf.Contract)
+		if nil != err {
+			return nil, err
+		}
+
+		if int64(len(tmp)) != end-start+1 {
+			return nil, fmt.Errorf("链上数据数量不一致 %d-%d", start, end)
+		}
+
+		for _, v := range tmp {
+			onChain = append(onChain, &biz.DepositOnChain{
+				Index:   v.Index,
+				Address: v.Address,
+				Amount:  source.toWei(v.Amount),
+			})
+		}
+	}
+
+	return u.uuc.AdminDepositReconcile(ctx, req, source.Conf.ChainId, source.Conf.Contract, source.Conf.Decimals, source.minWei(), onChain)
+}
+
+// AdminDepositSmallList 小额充值列表
+func (u *UserService) AdminDepositSmallList(ctx context.Context, req *pb.AdminDepositSmallListRequest) (*pb.AdminDepositSmallListReply, error) {
+	return u.uuc.AdminDepositSmallList(ctx, req)
+}
+
+// AdminDepositSmallResolve 小额充值处理
+func (u *UserService) AdminDepositSmallResolve(ctx context.Context, req *pb.AdminDepositSmallResolveRequest) (*pb.AdminDepositSmallResolveReply, error) {
+	return u.uuc.AdminDepositSmallResolve(ctx, req)
+}
+
+// AdminDepositReplay 补入账
+func (u *UserService) AdminDepositReplay(ctx context.Context, req *pb.AdminDepositReplayRequest) (*pb.AdminDepositReplayReply, error) {
+	return u.uuc.AdminDepositReplay(ctx, req)
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/rpcpool"
+	"context"
+	"testing"
+)
+
+func testDepositSource() *conf.Chain_DepositSource {
+	return &conf.Chain_DepositSource{
+		Name:          "test",
+		Decimals:      18,
+		MinAmount:     1,
+		Confirmations: 1,
+		StartBlock:    1,
+	}
+}
+
+func TestDepositJob(t *testing.T) {
+	ctx := context.Background()
+	alice, bob, stranger := mustKey(t), mustKey(t), mustKey(t)
+	chain := newTestChain(t, alice, bob, stranger)
+
+	repo := newTestStore(t)
+	repo.addUser(1, keyAddress(alice), "0")
+	repo.addUser(2, keyAddress(bob), "0")
+	u := newTestService(t, chain, repo, testDepositSource())
+
+	// 同一区块两笔，下一区块一笔未注册用户和一笔已注册用户
+	chain.buyFrom(t, alice, 100)
+	chain.buyFrom(t, bob, 20)
+	chain.backend.Commit()
+	chain.buyFrom(t, stranger, 5)
+	tx := chain.buyFrom(t, alice, 3)
+	chain.backend.Commit()
+
+	// 未达到确认数的区块不扫
+	if err := u.depositJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "100")
+	assertMoney(t, "bob", repo.balance(biz.UserAccount(2)), "20")
+
+	chain.backend.Commit()
+	if err := u.depositJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "103")
+	assertMoney(t, "bob", repo.balance(biz.UserAccount(2)), "20")
+	assertMoney(t, "deposit", repo.balance(biz.AccountDeposit), "-123")
+
+	if n := repo.count("eth_user_record"); 3 != n {
+		t.Fatalf("records = %d, want 3", n)
+	}
+
+	record, _ := repo.GetEthUserRecordByHash(tx.Hash().Hex())
+	if nil == record {
+		t.Fatal("record not found")
+	}
+	if 3 != record.DepositIndex || "3000000000000000000" != record.Amount || 3 != record.AmountTwo || 18 != record.Decimals {
+		t.Fatalf("record = %+v", record)
+	}
+
+	// 游标丢失后从头重扫，按 hash 和下标去重，不重复入账
+	repo.exec("delete from eth_block_cursor")
+	if err := u.depositJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "103")
+	if n := repo.count("eth_user_record"); 3 != n {
+		t.Fatalf("records = %d, want 3", n)
+	}
+}
+
+func TestDepositJobThroughContract(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := mustKey(t), mustKey(t)
+	chain := newTestChain(t, alice, bob)
+	proxy := chain.deployProxy(t)
+
+	repo := newTestStore(t)
+	repo.addUser(1, keyAddress(alice), "0")
+	repo.addUser(2, keyAddress(bob), "0")
+	repo.addUser(3, proxy.String(), "0")
+	u := newTestService(t, chain, repo, testDepositSource())
+
+	// 经合约调用的 buy 不是发给充值合约的顶层交易，后面的下标不能错位
+	first := chain.buyFrom(t, alice, 10)
+	chain.buyVia(t, bob, proxy, 20)
+	last := chain.buyFrom(t, alice, 30)
+	chain.backend.Commit()
+	chain.backend.Commit()
+
+	if err := u.depositJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		index  int64
+		userId int64
+		hash   string
+		amount uint64
+	}{
+		{0, 1, first.Hash().Hex(), 10},
+		{1, 3, "", 20},
+		{2, 1, last.Hash().Hex(), 30},
+	}
+	for _, v := range want {
+		record, _ := repo.GetEthUserRecordByIndex(testChainId, chain.buy.Hex(), v.index)
+		if nil == record || v.userId != record.UserId || v.hash != record.Hash || v.amount != record.AmountTwo {
+			t.Fatalf("record %d = %+v, want %+v", v.index, record, v)
+		}
+	}
+
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "40")
+	assertMoney(t, "proxy", repo.balance(biz.UserAccount(3)), "20")
+}
+
+func TestDepositJobRequiresStartBlock(t *testing.T) {
+	ctx := context.Background()
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+
+	repo := newTestStore(t)
+	repo.addUser(1, keyAddress(alice), "0")
+	source := testDepositSource()
+	source.StartBlock = 0
+	u := newTestService(t, chain, repo, source)
+
+	chain.buyFrom(t, alice, 10)
+	chain.backend.Commit()
+	chain.backend.Commit()
+
+	// 没有游标也没有起始区块时不从最新区块开始扫，避免漏掉旧逻辑之后的充值
+	if err := u.depositJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if records, cursors := repo.count("eth_user_record"), repo.count("eth_block_cursor"); 0 != records || 0 != cursors {
+		t.Fatalf("records = %d, cursors = %d", records, cursors)
+	}
+
+	repo.setConfig("deposit_start_block", "1")
+	if err := u.depositJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "10")
+}
+
+func TestDepositUserInfo(t *testing.T) {
+	ctx := context.Background()
+	alice, bob := mustKey(t), mustKey(t)
+	chain := newTestChain(t, alice, bob)
+	pool := rpcpool.NewStatic(chain.client)
+
+	chain.buyFrom(t, alice, 7)
+	chain.buyFrom(t, bob, 8)
+	chain.buyFrom(t, alice, 9)
+	chain.backend.Commit()
+
+	length, err := getUserLength(ctx, pool, chain.buy.Hex())
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 3 != length {
+		t.Fatalf("length = %d, want 3", length)
+	}
+
+	users, err := getUserInfo(ctx, pool, 1, 2, chain.buy.Hex())
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		index   int64
+		address string
+		amount  int64
+	}{
+		{1, keyAddress(bob), 8},
+		{2, keyAddress(alice), 9},
+	}
+	if len(want) != len(users) {
+		t.Fatalf("users = %d, want %d", len(users), len(want))
+	}
+	for k, v := range want {
+		if v.index != users[k].Index || v.address != users[k].Address || v.amount != users[k].Amount.Int64() {
+			t.Fatalf("users[%d] = %+v, want %+v", k, users[k], v)
+		}
+	}
+}
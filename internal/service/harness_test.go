@@ -0,0 +1,731 @@
+package service
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/data"
+	"cardbinance/internal/pkg/money"
+	"cardbinance/internal/pkg/rpcpool"
+	"cardbinance/internal/pkg/signer"
+	"context"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 模拟链固定 chainId 1337
+const testChainId = 1337
+
+// evmAsm 测试合约用的最小汇编器，本地没有 solc，按绑定的 ABI 手写合约
+type evmAsm struct {
+	code   []byte
+	labels map[string]int
+	fixups map[int]string
+}
+
+func newEvmAsm() *evmAsm {
+	return &evmAsm{labels: make(map[string]int), fixups: make(map[int]string)}
+}
+
+func (a *evmAsm) op(ops ...vm.OpCode) *evmAsm {
+	for _, v := range ops {
+		a.code = append(a.code, byte(v))
+	}
+	return a
+}
+
+// push 按最短长度压栈
+func (a *evmAsm) push(v *big.Int) *evmAsm {
+	b := v.Bytes()
+	if 0 >= len(b) {
+		b = []byte{0}
+	}
+
+	a.code = append(a.code, byte(vm.PUSH1)+byte(len(b)-1))
+	a.code = append(a.code, b...)
+	return a
+}
+
+func (a *evmAsm) pushN(v uint64) *evmAsm {
+	return a.push(new(big.Int).SetUint64(v))
+}
+
+// selector 函数选择器和栈顶比较，相等时跳到 label，栈顶保留
+func (a *evmAsm) selector(id []byte, label string) *evmAsm {
+	a.op(vm.DUP1, vm.PUSH4)
+	a.code = append(a.code, id...)
+	a.op(vm.EQ)
+	return a.jumpi(label)
+}
+
+func (a *evmAsm) jump(label string) *evmAsm {
+	return a.ref(label).op(vm.JUMP)
+}
+
+func (a *evmAsm) jumpi(label string) *evmAsm {
+	return a.ref(label).op(vm.JUMPI)
+}
+
+func (a *evmAsm) ref(label string) *evmAsm {
+	a.code = append(a.code, byte(vm.PUSH2), 0, 0)
+	a.fixups[len(a.code)-2] = label
+	return a
+}
+
+func (a *evmAsm) label(name string) *evmAsm {
+	a.labels[name] = len(a.code)
+	return a.op(vm.JUMPDEST)
+}
+
+// return32 返回栈顶一个字
+func (a *evmAsm) return32() *evmAsm {
+	return a.pushN(0).op(vm.MSTORE).pushN(32).pushN(0).op(vm.RETURN)
+}
+
+func (a *evmAsm) bytes() []byte {
+	res := append([]byte{}, a.code...)
+	for pos, name := range a.fixups {
+		target, ok := a.labels[name]
+		if !ok {
+			panic("evmAsm: unknown label " + name)
+		}
+
+		binary.BigEndian.PutUint16(res[pos:], uint16(target))
+	}
+
+	return res
+}
+
+// deployCode init 在构造时执行，之后把 runtime 复制出来作为合约代码，构造参数不读取
+func deployCode(init *evmAsm, runtime []byte) []byte {
+	// PUSH2 len DUP1 PUSH2 offset PUSH1 0 CODECOPY PUSH1 0 RETURN，共 13 字节
+	head := init.bytes()
+	offset := len(head) + 13
+	tail := newEvmAsm().op(vm.PUSH2)
+	tail.code = append(tail.code, byte(len(runtime)>>8), byte(len(runtime)))
+	tail.op(vm.DUP1, vm.PUSH2)
+	tail.code = append(tail.code, byte(offset>>8), byte(offset))
+	tail.code = append(tail.code, byte(vm.PUSH1), 0, byte(vm.CODECOPY), byte(vm.PUSH1), 0, byte(vm.RETURN))
+
+	return append(append(head, tail.code...), runtime...)
+}
+
+func methodId(t *testing.T, metaData *bind.MetaData, name string) []byte {
+	t.Helper()
+
+	parsed, err := metaData.GetAbi()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	method, ok := parsed.Methods[name]
+	if !ok {
+		t.Fatalf("method %s not found", name)
+	}
+
+	return method.ID
+}
+
+// 充值合约 users、usersAmount 两个数组的存储位置，长度存在 slot 0
+var (
+	buyUsersSlot  = new(big.Int).Lsh(big.NewInt(1), 64)
+	buyAmountSlot = new(big.Int).Lsh(big.NewInt(2), 64)
+)
+
+// buySomethingCode 充值合约，和线上合约一样 buy 时把调用者和数量追加到 users、usersAmount，不扣 usdt：
+//
+//	function buy(uint256 num) { users.push(msg.sender); usersAmount.push(num); }
+//	function getUserLength() returns (uint256) { return users.length; }
+//	function getUsersByIndex(uint256 start, uint256 end) returns (address[])  // 含 end
+//	function getUsersAmountByIndex(uint256 start, uint256 end) returns (uint256[])
+func buySomethingCode(t *testing.T) []byte {
+	a := newEvmAsm()
+	a.pushN(0).op(vm.CALLDATALOAD).pushN(0xe0).op(vm.SHR)
+	a.selector(methodId(t, BuySomethingMetaData, "buy"), "buy")
+	a.selector(methodId(t, BuySomethingMetaData, "getUserLength"), "length")
+	a.selector(methodId(t, BuySomethingMetaData, "getUsersByIndex"), "users")
+	a.selector(methodId(t, BuySomethingMetaData, "getUsersAmountByIndex"), "amounts")
+	a.label("revert").pushN(0).op(vm.DUP1, vm.REVERT)
+
+	// users[n] = caller; usersAmount[n] = num; n++
+	a.label("buy").pushN(0).op(vm.SLOAD)
+	a.op(vm.CALLER, vm.DUP2).push(buyUsersSlot).op(vm.ADD, vm.SSTORE)
+	a.pushN(4).op(vm.CALLDATALOAD, vm.DUP2).push(buyAmountSlot).op(vm.ADD, vm.SSTORE)
+	a.pushN(1).op(vm.ADD).pushN(0).op(vm.SSTORE, vm.STOP)
+
+	a.label("length").pushN(0).op(vm.SLOAD).return32()
+
+	a.label("users").push(buyUsersSlot).jump("slice")
+	a.label("amounts").push(buyAmountSlot).jump("slice")
+
+	// 栈 [base]，返回 base+start 到 base+end 的动态数组
+	a.label("slice").pushN(4).op(vm.CALLDATALOAD).pushN(0x24).op(vm.CALLDATALOAD)
+	a.op(vm.DUP2, vm.DUP2, vm.SUB).pushN(1).op(vm.ADD, vm.SWAP1, vm.POP) // [base, start, len]
+	a.pushN(0x20).pushN(0).op(vm.MSTORE)
+	a.op(vm.DUP1).pushN(0x20).op(vm.MSTORE)
+	a.pushN(0) // [base, start, len, i]
+	a.label("loop").op(vm.DUP2, vm.DUP2, vm.LT, vm.ISZERO).jumpi("done")
+	a.op(vm.DUP1, vm.DUP4, vm.ADD, vm.DUP5, vm.ADD, vm.SLOAD)
+	a.op(vm.DUP2).pushN(0x20).op(vm.MUL).pushN(0x40).op(vm.ADD, vm.MSTORE)
+	a.pushN(1).op(vm.ADD).jump("loop")
+	a.label("done").op(vm.POP).pushN(0x20).op(vm.MUL).pushN(0x40).op(vm.ADD).pushN(0).op(vm.RETURN)
+
+	return deployCode(newEvmAsm(), a.bytes())
+}
+
+// proxyCode 把调用原样转给 target，模拟经其他合约调用充值合约 buy，合约里记录的 msg.sender 是本合约
+func proxyCode(target common.Address) []byte {
+	a := newEvmAsm()
+	a.op(vm.CALLDATASIZE).pushN(0).pushN(0).op(vm.CALLDATACOPY)
+	a.pushN(0).pushN(0).op(vm.CALLDATASIZE).pushN(0).pushN(0).push(new(big.Int).SetBytes(target.Bytes())).op(vm.GAS, vm.CALL)
+	a.op(vm.ISZERO).jumpi("revert").op(vm.STOP)
+	a.label("revert").pushN(0).op(vm.DUP1, vm.REVERT)
+
+	return deployCode(newEvmAsm(), a.bytes())
+}
+
+// tokenCode 18 位小数的代币，部署时 supply 全部给部署地址，余额按地址存：
+//
+//	function balanceOf(address) returns (uint256)
+//	function transfer(address to, uint256 amount) returns (bool)  // 余额不足 revert
+//	function decimals() returns (uint8)
+func tokenCode(t *testing.T, supply *big.Int) []byte {
+	a := newEvmAsm()
+	a.pushN(0).op(vm.CALLDATALOAD).pushN(0xe0).op(vm.SHR)
+	a.selector(methodId(t, DfilMetaData, "balanceOf"), "balanceOf")
+	a.selector(methodId(t, DfilMetaData, "transfer"), "transfer")
+	a.selector(methodId(t, DfilMetaData, "decimals"), "decimals")
+	a.label("revert").pushN(0).op(vm.DUP1, vm.REVERT)
+
+	a.label("balanceOf").pushN(4).op(vm.CALLDATALOAD, vm.SLOAD).return32()
+	a.label("decimals").pushN(18).return32()
+
+	a.label("transfer").op(vm.CALLER, vm.SLOAD).pushN(0x24).op(vm.CALLDATALOAD) // [bal, amount]
+	a.op(vm.DUP1, vm.DUP3, vm.LT).jumpi("revert")
+	a.op(vm.DUP1, vm.DUP3, vm.SUB, vm.CALLER, vm.SSTORE)
+	a.pushN(4).op(vm.CALLDATALOAD, vm.DUP1, vm.SLOAD, vm.DUP3, vm.ADD, vm.SWAP1, vm.SSTORE)
+	a.op(vm.POP, vm.POP).pushN(1).return32()
+
+	init := newEvmAsm().push(supply).op(vm.CALLER, vm.SSTORE)
+	return deployCode(init, a.bytes())
+}
+
+// archiveClient 模拟链只能查最新区块，扫块时要按历史区块调用合约，这里补上，和归档节点一致
+type archiveClient struct {
+	*backends.SimulatedBackend
+}
+
+func (c *archiveClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	bc := c.Blockchain()
+	if nil == blockNumber || 0 == blockNumber.Cmp(bc.CurrentBlock().Number()) {
+		return c.SimulatedBackend.CallContract(ctx, call, blockNumber)
+	}
+
+	block := bc.GetBlockByNumber(blockNumber.Uint64())
+	if nil == block {
+		return nil, ethereum.NotFound
+	}
+
+	stateDB, err := bc.StateAt(block.Root())
+	if nil != err {
+		return nil, err
+	}
+
+	msg := types.NewMessage(call.From, call.To, 0, new(big.Int), 50000000, new(big.Int), new(big.Int), new(big.Int), call.Data, nil, true)
+	evm := vm.NewEVM(core.NewEVMBlockContext(block.Header(), bc, nil), core.NewEVMTxContext(msg), stateDB, bc.Config(), vm.Config{NoBaseFee: true})
+	res, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64))
+	if nil != err {
+		return nil, err
+	}
+
+	if nil != res.Err {
+		return nil, res.Err
+	}
+
+	return res.Return(), nil
+}
+
+// testChain 模拟链，热钱包有 ETH 和全部代币，充值合约和代币已部署
+type testChain struct {
+	backend *backends.SimulatedBackend
+	client  *archiveClient
+	hotKey  *ecdsa.PrivateKey
+	hot     signer.Signer
+	buy     common.Address
+	token   common.Address
+}
+
+func newTestChain(t *testing.T, users ...*ecdsa.PrivateKey) *testChain {
+	t.Helper()
+
+	hotKey, err := crypto.GenerateKey()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	ether := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	alloc := core.GenesisAlloc{crypto.PubkeyToAddress(hotKey.PublicKey): {Balance: new(big.Int).Mul(ether, big.NewInt(100))}}
+	for _, v := range users {
+		alloc[crypto.PubkeyToAddress(v.PublicKey)] = core.GenesisAccount{Balance: new(big.Int).Mul(ether, big.NewInt(10))}
+	}
+
+	c := &testChain{hotKey: hotKey}
+	c.backend = backends.NewSimulatedBackend(alloc, 30000000)
+	t.Cleanup(func() { _ = c.backend.Close() })
+	c.client = &archiveClient{SimulatedBackend: c.backend}
+
+	t.Setenv("TEST_WITHDRAW_KEY", hexutil.Encode(crypto.FromECDSA(hotKey)))
+	c.hot, err = signer.NewEnv("TEST_WITHDRAW_KEY")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	supply := new(big.Int).Mul(ether, big.NewInt(1000000))
+	c.token = c.deploy(t, DfilMetaData, tokenCode(t, supply), supply)
+	c.buy = c.deploy(t, BuySomethingMetaData, buySomethingCode(t), c.token)
+	c.backend.Commit()
+
+	return c
+}
+
+// deploy 按绑定的 ABI 部署，构造参数照常编码，测试合约不读取
+func (c *testChain) deploy(t *testing.T, metaData *bind.MetaData, code []byte, params ...interface{}) common.Address {
+	t.Helper()
+
+	parsed, err := metaData.GetAbi()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	address, _, _, err := bind.DeployContract(c.transactor(t, c.hotKey), *parsed, code, c.backend, params...)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return address
+}
+
+func (c *testChain) transactor(t *testing.T, key *ecdsa.PrivateKey) *bind.TransactOpts {
+	t.Helper()
+
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(testChainId))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return opts
+}
+
+// buyFrom 用户调用充值合约 buy，不出块
+func (c *testChain) buyFrom(t *testing.T, key *ecdsa.PrivateKey, num int64) *types.Transaction {
+	t.Helper()
+
+	instance, err := NewBuySomething(c.buy, c.backend)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	tx, err := instance.Buy(c.transactor(t, key), big.NewInt(num))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return tx
+}
+
+// deployProxy 部署转发到充值合约的代理合约
+func (c *testChain) deployProxy(t *testing.T) common.Address {
+	t.Helper()
+
+	address, tx, _, err := bind.DeployContract(c.transactor(t, c.hotKey), abi.ABI{}, proxyCode(c.buy), c.backend)
+	if nil != err {
+		t.Fatal(err)
+	}
+	c.backend.Commit()
+
+	receipt, err := c.backend.TransactionReceipt(context.Background(), tx.Hash())
+	if nil != err || types.ReceiptStatusSuccessful != receipt.Status {
+		t.Fatalf("deploy proxy: %v", err)
+	}
+
+	return address
+}
+
+// buyVia 用户经代理合约调用 buy，不出块
+func (c *testChain) buyVia(t *testing.T, key *ecdsa.PrivateKey, proxy common.Address, num int64) *types.Transaction {
+	t.Helper()
+
+	instance, err := NewBuySomething(proxy, c.backend)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	opts := c.transactor(t, key)
+	opts.GasLimit = 200000
+	tx, err := instance.Buy(opts, big.NewInt(num))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return tx
+}
+
+func (c *testChain) tokenBalance(t *testing.T, address common.Address) *big.Int {
+	t.Helper()
+
+	instance, err := NewDfil(c.token, c.backend)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	res, err := instance.BalanceOf(&bind.CallOpts{}, address)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return res
+}
+
+// testTables 充值、提现、划转流程用到的表，按 data 层的模型建表
+var testTables = []struct {
+	name  string
+	model interface{}
+}{
+	{"user", &data.User{}},
+	{"config", &data.Config{}},
+	{"reward", &data.Reward{}},
+	{"withdraw", &data.Withdraw{}},
+	{"fee_record", &data.FeeRecord{}},
+	{"eth_user_record", &data.EthUserRecord{}},
+	{"eth_block_cursor", &data.EthBlockCursor{}},
+	{"eth_deposit_pending", &data.EthDepositPending{}},
+	{"ledger_account", &data.LedgerAccount{}},
+	{"ledger_journal", &data.LedgerJournal{}},
+	{"ledger_posting", &data.LedgerPosting{}},
+	{"transfer", &data.Transfer{}},
+	{"balance_drift", &data.BalanceDrift{}},
+}
+
+// testStore 测试库上的 data.UserRepo，redis 用 miniredis。
+// TEST_MYSQL_DSN 指向专用的测试库（需带 parseTime=true&loc=Local），每个测试重建表，未配置时跳过
+type testStore struct {
+	biz.UserRepo
+
+	t   *testing.T
+	db  *gorm.DB
+	rdb *redis.Client
+	tx  biz.Transaction
+
+	mu sync.Mutex
+	// locks 事务内锁住的转出用户，按次数记
+	locks map[uint64]int
+	// beforeList 按状态查询提现前调用，模拟任务执行中途新建的提现
+	beforeList func(status string)
+	// reviewErr 转审核返回的错误
+	reviewErr error
+}
+
+func newTestStore(t *testing.T) *testStore {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_MYSQL_DSN")
+	if "" == dsn {
+		t.Skip("TEST_MYSQL_DSN 未配置，跳过数据库测试")
+	}
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		Logger:                                   logger.Default.LogMode(logger.Silent),
+		DisableForeignKeyConstraintWhenMigrating: true,
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	sqlDB, err := db.DB()
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	for _, v := range testTables {
+		if err = db.Migrator().DropTable(v.name); nil != err {
+			t.Fatal(err)
+		}
+		if err = db.Table(v.name).AutoMigrate(v.model); nil != err {
+			t.Fatalf("migrate %s: %v", v.name, err)
+		}
+	}
+
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	d, _, err := data.NewData(&conf.Data{}, log.DefaultLogger, db, rdb)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return &testStore{
+		UserRepo: data.NewUserRepo(d, log.DefaultLogger),
+		t:        t,
+		db:       db,
+		rdb:      rdb,
+		tx:       data.NewTransaction(d),
+		locks:    make(map[uint64]int),
+	}
+}
+
+func (s *testStore) setConfig(key, value string) {
+	s.t.Helper()
+
+	if err := s.db.Table("config").Where("key_name=?", key).Delete(&data.Config{}).Error; nil != err {
+		s.t.Fatal(err)
+	}
+	if err := s.db.Table("config").Create(&data.Config{Name: key, KeyName: key, Value: value}).Error; nil != err {
+		s.t.Fatal(err)
+	}
+}
+
+// addUser amount 为记账前的余额，第一次记账时记为期初
+func (s *testStore) addUser(id uint64, address string, amount string) {
+	s.t.Helper()
+
+	user := &data.User{ID: id, Address: address, Amount: money.MustParse(amount)}
+	if err := s.db.Table("user").Create(user).Error; nil != err {
+		s.t.Fatal(err)
+	}
+}
+
+// addWithdraw 提现记录，和 UserUseCase.Withdraw 一样记扣款分录
+func (s *testStore) addWithdraw(w *biz.Withdraw) {
+	s.t.Helper()
+
+	withdraw := &data.Withdraw{
+		ID:        w.ID,
+		UserId:    w.UserId,
+		Amount:    w.Amount,
+		RelAmount: w.RelAmount,
+		Fee:       w.Fee,
+		Status:    w.Status,
+		Address:   w.Address,
+		TxHash:    w.TxHash,
+		Nonce:     w.Nonce,
+		RawTx:     w.RawTx,
+		Dust:      w.Dust,
+		CarryId:   w.CarryId,
+		UpdatedAt: w.UpdatedAt,
+	}
+	if !w.BroadcastAt.IsZero() {
+		withdraw.BroadcastAt = &w.BroadcastAt
+	}
+	if err := s.db.Table("withdraw").Create(withdraw).Error; nil != err {
+		s.t.Fatal(err)
+	}
+
+	j := biz.NewJournal(pb.RewardReason_REWARD_REASON_WITHDRAW, "withdraw:"+strconv.FormatUint(w.ID, 10))
+	j.Postings = append(j.Postings,
+		&biz.Posting{Account: biz.UserAccount(w.UserId), Amount: w.Amount.Neg()},
+		&biz.Posting{Account: biz.AccountWithdraw, Amount: w.Amount.Sub(w.Fee)},
+	)
+	if 0 < w.Fee.Sign() {
+		j.Postings = append(j.Postings, &biz.Posting{Account: biz.AccountFee, Amount: w.Fee})
+	}
+
+	err := s.tx.ExecTx(context.Background(), func(ctx context.Context) error {
+		return s.UserRepo.PostJournal(ctx, j)
+	})
+	if nil != err {
+		s.t.Fatal(err)
+	}
+}
+
+// postAt 记账并把分录时间改为 at
+func (s *testStore) postAt(j *biz.Journal, at time.Time) {
+	s.t.Helper()
+
+	err := s.tx.ExecTx(context.Background(), func(ctx context.Context) error {
+		return s.UserRepo.PostJournal(ctx, j)
+	})
+	if nil != err {
+		s.t.Fatal(err)
+	}
+
+	s.exec("update ledger_posting set created_at=? where journal_id=?", at, j.ID)
+}
+
+func (s *testStore) withdraw(id uint64) *biz.Withdraw {
+	s.t.Helper()
+
+	w, err := s.GetWithdrawById(id)
+	if nil != err || nil == w {
+		s.t.Fatalf("withdraw %d: %v", id, err)
+	}
+
+	return w
+}
+
+// balance 用户账户取 user.amount，平台账户按分录合计
+func (s *testStore) balance(account string) money.Money {
+	s.t.Helper()
+
+	var (
+		res money.Money
+		err error
+	)
+
+	if userId := biz.AccountUserId(account); 0 < userId {
+		err = s.db.Table("user").Where("id=?", userId).Select("amount").Row().Scan(&res)
+	} else {
+		res, err = s.GetLedgerAccountBalance(context.Background(), account)
+	}
+	if nil != err {
+		s.t.Fatal(err)
+	}
+
+	return res
+}
+
+// refunds 提现的退款分录笔数
+func (s *testStore) refunds(id uint64) int64 {
+	s.t.Helper()
+
+	var res int64
+	err := s.db.Table("ledger_journal").
+		Where("ref=? and reason<>?", "withdraw:"+strconv.FormatUint(id, 10), pb.RewardReason_REWARD_REASON_WITHDRAW).
+		Count(&res).Error
+	if nil != err {
+		s.t.Fatal(err)
+	}
+
+	return res
+}
+
+func (s *testStore) count(table string) int64 {
+	s.t.Helper()
+
+	var res int64
+	if err := s.db.Table(table).Count(&res).Error; nil != err {
+		s.t.Fatal(err)
+	}
+
+	return res
+}
+
+func (s *testStore) exec(sql string, values ...interface{}) {
+	s.t.Helper()
+
+	if err := s.db.Exec(sql, values...).Error; nil != err {
+		s.t.Fatal(err)
+	}
+}
+
+// nonceFree 放回的 nonce，从小到大
+func (s *testStore) nonceFree(address string) []string {
+	s.t.Helper()
+
+	res, err := s.rdb.ZRange(context.Background(), "nonce_free:"+strings.ToLower(address), 0, -1).Result()
+	if nil != err {
+		s.t.Fatal(err)
+	}
+
+	return res
+}
+
+func (s *testStore) LockTransferUser(ctx context.Context, userId uint64) (*biz.User, error) {
+	s.mu.Lock()
+	s.locks[userId]++
+	s.mu.Unlock()
+
+	return s.UserRepo.LockTransferUser(ctx, userId)
+}
+
+func (s *testStore) GetWithdrawsByStatus(status string, limit int) ([]*biz.Withdraw, error) {
+	if nil != s.beforeList {
+		s.beforeList(status)
+	}
+
+	return s.UserRepo.GetWithdrawsByStatus(status, limit)
+}
+
+func (s *testStore) UpdateWithdrawReview(ctx context.Context, id uint64, from, to string, remark string) error {
+	if nil != s.reviewErr && "review" == to {
+		return s.reviewErr
+	}
+
+	return s.UserRepo.UpdateWithdrawReview(ctx, id, from, to, remark)
+}
+
+// newTestService 链上走 rpcpool.NewStatic，数据走 testStore，充值来源为模拟链上的充值合约
+func newTestService(t *testing.T, chain *testChain, repo *testStore, source *conf.Chain_DepositSource) *UserService {
+	t.Helper()
+
+	pool := rpcpool.NewStatic(chain.client)
+	cc := &conf.Chain{
+		ChainId:       testChainId,
+		WithdrawToken: chain.token.Hex(),
+	}
+
+	u := &UserService{
+		uuc:    biz.NewUserUseCase(repo, repo.tx, nil, nil, log.DefaultLogger),
+		log:    log.NewHelper(log.DefaultLogger),
+		cc:     cc,
+		pool:   pool,
+		signer: chain.hot,
+	}
+
+	if nil != source {
+		source.ChainId = testChainId
+		source.Contract = chain.buy.Hex()
+		cc.DepositSources = append(cc.DepositSources, source)
+		u.sources = DepositSources{&DepositSource{Conf: source, Pool: pool}}
+	}
+
+	return u
+}
+
+// keyAddress 和 depositScanner 解析出的发送者格式一致
+func keyAddress(key *ecdsa.PrivateKey) string {
+	return crypto.PubkeyToAddress(key.PublicKey).String()
+}
+
+func mustKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+func assertMoney(t *testing.T, name string, got money.Money, want string) {
+	t.Helper()
+
+	if 0 != got.Cmp(money.MustParse(want)) {
+		t.Fatalf("%s = %s, want %s", name, got, want)
+	}
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/scheduler"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"time"
+)
+
+// registerJobs 注册定时任务，间隔和超时按配置，未配置的只能手动触发
+func (u *UserService) registerJobs(cs *conf.Scheduler) {
+	jobs := map[string]func(ctx context.Context) error{
+		"deposit":           u.depositJob,
+		"deposit_reconcile": u.depositReconcileJob,
+		"withdraw":          u.withdrawJob,
+		"withdraw_watch":    u.withdrawWatchJob,
+		"email": func(ctx context.Context) error {
+			_, err := u.uuc.EmailGet(ctx, &pb.EmailGetRequest{})
+			return err
+		},
+		"reward_card_two": u.uuc.CardTwoStatusHandle,
+		"update_all_card": func(ctx context.Context) error {
+			_, err := u.uuc.UpdateAllCard(ctx, &pb.UpdateAllCardRequest{})
+			return err
+		},
+		"update_all_card_one": func(ctx context.Context) error {
+			_, err := u.uuc.UpdateAllCardTwo(ctx, &pb.UpdateAllCardRequest{})
+			return err
+		},
+		"pull_all_card": func(ctx context.Context) error {
+			_, err := u.uuc.PullAllCard(ctx, &pb.PullAllCardRequest{})
+			return err
+		},
+		"auto_update_all_card": func(ctx context.Context) error {
+			_, err := u.uuc.AutoUpdateAllCard(ctx, &pb.UpdateAllCardRequest{})
+			return err
+		},
+		"ledger_rebuild": func(ctx context.Context) error {
+			_, err := u.uuc.LedgerRebuildAll(ctx)
+			return err
+		},
+		"balance_reconcile": u.balanceReconcileJob,
+	}
+
+	configs := make(map[string]*conf.Scheduler_Job, 0)
+	for _, v := range cs.GetJobs() {
+		configs[v.Name] = v
+	}
+
+	for name, run := range jobs {
+		job := scheduler.Job{
+			Name: name,
+			Run:  run,
+		}
+
+		if v, ok := configs[name]; ok {
+			job.Interval = v.Interval.AsDuration()
+			job.Timeout = v.Timeout.AsDuration()
+		}
+
+		u.jobs.Register(job)
+	}
+}
+
+// balanceReconcileJob 余额对账，有差异时告警
+func (u *UserService) balanceReconcileJob(ctx context.Context) error {
+	runId, drifts, err := u.uuc.ReconcileBalances(ctx)
+	if nil != err {
+		return err
+	}
+
+	if 0 >= len(drifts) || nil == u.notify {
+		return nil
+	}
+
+	text := fmt.Sprintf("批次 %s，%d 个用户余额和记录不一致", runId, len(drifts))
+	for i, v := range drifts {
+		if 10 <= i {
+			text += "\n..."
+			break
+		}
+		text += fmt.Sprintf("\n用户 %d 余额 %s 应有 %s 差额 %s", v.UserId, v.Stored, v.Expected, v.Drift)
+	}
+
+	if err = u.notify.Notify(ctx, "余额对账差异", text); nil != err {
+		fmt.Println("对账告警发送失败", runId, err)
+	}
+
+	return nil
+}
+
+// depositReconcileJob 全部充值来源对账，有差异时告警，明细到后台充值对账查看
+func (u *UserService) depositReconcileJob(ctx context.Context) error {
+	text := ""
+	for _, v := range u.sources {
+		res, err := u.AdminDepositReconcile(ctx, &pb.AdminDepositReconcileRequest{Source: v.Conf.Name})
+		if nil != err {
+			return err
+		}
+
+		fmt.Println("充值对账", v.Conf.Name, res.OnChainCount, res.RecordCount, res.MatchedCount, len(res.Items))
+		if 0 >= len(res.Items) {
+			continue
+		}
+
+		kinds := make(map[string]int, 0)
+		for _, vItem := range res.Items {
+			kinds[vItem.Kind]++
+		}
+
+		text += fmt.Sprintf("\n%s 链上 %d 笔，记录 %d 条，一致 %d，未入账 %d，重复 %d，不一致 %d",
+			v.Conf.Name, res.OnChainCount, res.RecordCount, res.MatchedCount, kinds["missing"], kinds["duplicate"], kinds["mismatch"])
+	}
+
+	if "" == text || nil == u.notify {
+		return nil
+	}
+
+	if err := u.notify.Notify(ctx, "充值对账差异", text[1:]); nil != err {
+		fmt.Println("充值对账告警发送失败", err)
+	}
+
+	return nil
+}
+
+// triggerJob 兼容外部 cron 调用，任务执行中时忽略
+func (u *UserService) triggerJob(name string) {
+	if err := u.jobs.Trigger(name); nil != err {
+		fmt.Println("触发定时任务", name, err)
+	}
+}
+
+// AdminJobList 定时任务列表和最近一次执行情况
+func (u *UserService) AdminJobList(ctx context.Context, req *pb.AdminJobListRequest) (*pb.AdminJobListReply, error) {
+	res := &pb.AdminJobListReply{
+		List: make([]*pb.AdminJobListReply_List, 0),
+	}
+
+	for _, v := range u.jobs.Statuses() {
+		tmp := &pb.AdminJobListReply_List{
+			Name:       v.Name,
+			Interval:   uint64(v.Interval / time.Second),
+			Timeout:    uint64(v.Timeout / time.Second),
+			Running:    v.Running,
+			LastResult: v.LastResult,
+			LastError:  v.LastError,
+			RunCount:   v.RunCount,
+		}
+
+		if !v.LastStart.IsZero() {
+			tmp.LastStart = v.LastStart.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+		}
+		if !v.LastEnd.IsZero() {
+			tmp.LastEnd = v.LastEnd.Add(8 * time.Hour).Format("2006-01-02 15:04:05")
+		}
+
+		res.List = append(res.List, tmp)
+	}
+
+	return res, nil
+}
+
+// AdminJobRun 手动执行一次定时任务
+func (u *UserService) AdminJobRun(ctx context.Context, req *pb.AdminJobRunRequest) (*pb.AdminJobRunReply, error) {
+	if nil == req.SendBody {
+		return nil, errors.New(400, "PARAM_ERROR", "缺少参数")
+	}
+
+	err := u.jobs.Trigger(req.SendBody.Name)
+	if scheduler.ErrJobNotFound == err {
+		return &pb.AdminJobRunReply{Status: "任务不存在"}, nil
+	}
+	if scheduler.ErrJobRunning == err {
+		return &pb.AdminJobRunReply{Status: "任务执行中"}, nil
+	}
+	if scheduler.ErrStopped == err {
+		return &pb.AdminJobRunReply{Status: "服务停止中"}, nil
+	}
+	if nil != err {
+		return nil, err
+	}
+
+	return &pb.AdminJobRunReply{Status: "ok"}, nil
+}
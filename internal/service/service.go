@@ -1,6 +1,239 @@
 package service
 
-import "github.com/google/wire"
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/notify"
+	"cardbinance/internal/pkg/rpcpool"
+	"cardbinance/internal/pkg/scheduler"
+	"cardbinance/internal/pkg/signer"
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/wire"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewUserService)
+var ProviderSet = wire.NewSet(NewUserService, NewChainPool, NewDepositSources, NewScheduler, NewSigner, NewNotifier, NewISPayConfig, NewInterlaceConfig)
+
+// NewChainPool 链上调用统一走节点池
+func NewChainPool(c *conf.Chain) (rpcpool.Backend, func()) {
+	pool := newPool(c.Rpc)
+	return pool, pool.Close
+}
+
+// NewScheduler 定时任务随应用启停，多实例用 Redis 租约互斥
+func NewScheduler(c *conf.Scheduler, rdb *redis.Client) *scheduler.Scheduler {
+	return scheduler.New(rdb, c.GetLeasePrefix())
+}
+
+// NewSigner 提现签名，提现任务自动执行时未配置或不可用则不能启动，否则只打印，提现任务不执行
+func NewSigner(c *conf.Chain, cs *conf.Scheduler) (signer.Signer, error) {
+	var (
+		s   signer.Signer
+		err error
+	)
+
+	enabled := withdrawEnabled(cs)
+	sc := c.GetSigner()
+	switch sc.GetType() {
+	case "keystore":
+		passphrase := sc.GetPassphrase()
+		if "" != sc.GetPassphraseEnv() {
+			passphrase = os.Getenv(sc.GetPassphraseEnv())
+		}
+		s, err = signer.NewKeystore(sc.GetKeystorePath(), passphrase)
+	case "env":
+		s, err = signer.NewEnv(sc.GetKeyEnv())
+	case "remote":
+		s, err = signer.NewRemote(sc.GetRemoteUrl(), sc.GetRemoteAddress(), os.Getenv(sc.GetRemoteTokenEnv()), sc.GetRemoteTimeout().AsDuration())
+	default:
+		if enabled {
+			return nil, fmt.Errorf("已开启提现任务，未配置提现签名")
+		}
+
+		fmt.Println("未配置提现签名")
+		return nil, nil
+	}
+
+	// 远程签名要实际签一笔才知道服务和地址是否可用
+	if nil == err && enabled {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		err = signer.Check(ctx, s, new(big.Int).SetInt64(c.GetChainId()))
+		cancel()
+	}
+
+	if nil != err {
+		if enabled {
+			return nil, fmt.Errorf("提现签名加载失败 %s: %w", sc.GetType(), err)
+		}
+
+		fmt.Println("提现签名加载失败", sc.GetType(), err)
+		return nil, nil
+	}
+
+	fmt.Println("提现地址", s.Address().Hex())
+	return s, nil
+}
+
+// withdrawEnabled 提现任务配置了执行间隔，会自动发放
+func withdrawEnabled(cs *conf.Scheduler) bool {
+	for _, v := range cs.GetJobs() {
+		if "withdraw" == v.GetName() && 0 < v.GetInterval().AsDuration() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewNotifier 告警通知，未配置时不告警
+func NewNotifier(c *conf.Notify) notify.Notifier {
+	switch c.GetType() {
+	case "log":
+		return notify.Log{}
+	case "webhook":
+		n, err := notify.NewWebhook(c.GetWebhookUrl(), c.GetTimeout().AsDuration())
+		if nil != err {
+			fmt.Println("告警通知加载失败", err)
+			return nil
+		}
+
+		return n
+	default:
+		return nil
+	}
+}
+
+// loadSecret 渠道密钥，密钥文件优先，其次环境变量，最后是配置里的明文。
+// 配了密钥文件却读不到时报错，不拿空密钥去请求渠道
+func loadSecret(value, env, file string) (string, error) {
+	if "" != file {
+		b, err := os.ReadFile(file)
+		if nil != err {
+			return "", fmt.Errorf("密钥文件读取失败 %s: %w", file, err)
+		}
+
+		return strings.TrimSpace(string(b)), nil
+	}
+
+	if "" != env {
+		return os.Getenv(env), nil
+	}
+
+	return value, nil
+}
+
+// NewISPayConfig 取当前环境的 ISPay 配置，当前环境没配置或密钥读取失败时启动报错
+func NewISPayConfig(c *conf.Vendors) (*biz.ISPayConfig, error) {
+	v, ok := c.GetIspay()[c.GetProfile()]
+	if !ok {
+		return nil, fmt.Errorf("未配置 ISPay %s", c.GetProfile())
+	}
+
+	signKey, err := loadSecret(v.GetSignKey(), v.GetSignKeyEnv(), v.GetSignKeyFile())
+	if nil != err {
+		return nil, fmt.Errorf("ISPay %s: %w", c.GetProfile(), err)
+	}
+
+	holderBaseURL := v.GetHolderBaseUrl()
+	if "" == holderBaseURL {
+		holderBaseURL = v.GetBaseUrl()
+	}
+
+	return &biz.ISPayConfig{
+		BaseURL:       v.GetBaseUrl(),
+		HolderBaseURL: holderBaseURL,
+		MerchantId:    v.GetMerchantId(),
+		SignKey:       signKey,
+		Timeout:       v.GetTimeout().AsDuration(),
+	}, nil
+}
+
+// NewInterlaceConfig 取当前环境的 Interlace 配置，上传超时未配置时同 timeout。
+// 当前环境没配置或密钥读取失败时启动报错
+func NewInterlaceConfig(c *conf.Vendors) (*biz.InterlaceConfig, error) {
+	v, ok := c.GetInterlace()[c.GetProfile()]
+	if !ok {
+		return nil, fmt.Errorf("未配置 Interlace %s", c.GetProfile())
+	}
+
+	clientSecret, err := loadSecret(v.GetClientSecret(), v.GetClientSecretEnv(), v.GetClientSecretFile())
+	if nil != err {
+		return nil, fmt.Errorf("Interlace %s: %w", c.GetProfile(), err)
+	}
+
+	uploadTimeout := v.GetUploadTimeout().AsDuration()
+	if 0 >= uploadTimeout {
+		uploadTimeout = v.GetTimeout().AsDuration()
+	}
+
+	return &biz.InterlaceConfig{
+		BaseURL:       v.GetBaseUrl(),
+		BaseURLV1:     v.GetBaseUrlV1(),
+		ClientId:      v.GetClientId(),
+		ClientSecret:  clientSecret,
+		AccountId:     v.GetAccountId(),
+		Timeout:       v.GetTimeout().AsDuration(),
+		UploadTimeout: uploadTimeout,
+	}, nil
+}
+
+// DepositSource 一个充值来源，链、合约和节点各自独立
+type DepositSource struct {
+	Conf *conf.Chain_DepositSource
+	Pool rpcpool.Backend
+}
+
+// toWei 合约原始金额换算成代币最小单位
+func (s *DepositSource) toWei(amount *big.Int) *big.Int {
+	return biz.ScaleAmount(amount, s.Conf.AmountDecimals, s.Conf.Decimals)
+}
+
+// minWei 最小充值金额，配置为整数个代币
+func (s *DepositSource) minWei() *big.Int {
+	return biz.ScaleAmount(new(big.Int).SetUint64(s.Conf.MinAmount), 0, s.Conf.Decimals)
+}
+
+type DepositSources []*DepositSource
+
+// NewDepositSources 未配置节点的来源使用默认节点配置
+func NewDepositSources(c *conf.Chain) (DepositSources, func()) {
+	res := make(DepositSources, 0, len(c.DepositSources))
+	pools := make([]*rpcpool.Pool, 0, len(c.DepositSources))
+	for _, v := range c.DepositSources {
+		rpc := v.Rpc
+		if 0 >= len(rpc.GetEndpoints()) {
+			rpc = c.Rpc
+		}
+
+		pool := newPool(rpc)
+		pools = append(pools, pool)
+		res = append(res, &DepositSource{
+			Conf: v,
+			Pool: pool,
+		})
+	}
+
+	return res, func() {
+		for _, v := range pools {
+			v.Close()
+		}
+	}
+}
+
+func newPool(c *conf.Chain_Rpc) *rpcpool.Pool {
+	return rpcpool.New(rpcpool.Config{
+		Endpoints:     c.GetEndpoints(),
+		Timeout:       c.GetTimeout().AsDuration(),
+		MaxFailures:   c.GetMaxFailures(),
+		Cooldown:      c.GetCooldown().AsDuration(),
+		MaxLag:        c.GetMaxLag(),
+		CheckInterval: c.GetCheckInterval().AsDuration(),
+		Retries:       c.GetRetries(),
+	})
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"cardbinance/internal/conf"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_SIGNER_KEY", hexutil.Encode(crypto.FromECDSA(key)))
+	t.Setenv("TEST_SIGNER_EMPTY", "")
+
+	enabled := &conf.Scheduler{Jobs: []*conf.Scheduler_Job{{Name: "withdraw", Interval: durationpb.New(10 * time.Second)}}}
+	manual := &conf.Scheduler{Jobs: []*conf.Scheduler_Job{{Name: "withdraw"}}}
+
+	tests := []struct {
+		name    string
+		signer  *conf.Chain_Signer
+		jobs    *conf.Scheduler
+		wantErr bool
+		wantNil bool
+	}{
+		{"开启提现未配置", nil, enabled, true, true},
+		{"开启提现私钥为空", &conf.Chain_Signer{Type: "env", KeyEnv: "TEST_SIGNER_EMPTY"}, enabled, true, true},
+		{"开启提现keystore不存在", &conf.Chain_Signer{Type: "keystore", KeystorePath: "not-exist.json"}, enabled, true, true},
+		{"开启提现远程服务不可用", &conf.Chain_Signer{Type: "remote", RemoteUrl: "http://127.0.0.1:1", RemoteAddress: crypto.PubkeyToAddress(key.PublicKey).Hex()}, enabled, true, true},
+		{"开启提现配置正确", &conf.Chain_Signer{Type: "env", KeyEnv: "TEST_SIGNER_KEY"}, enabled, false, false},
+		{"手动提现未配置", nil, manual, false, true},
+		{"手动提现私钥为空", &conf.Chain_Signer{Type: "env", KeyEnv: "TEST_SIGNER_EMPTY"}, manual, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewSigner(&conf.Chain{ChainId: 56, Signer: tt.signer}, tt.jobs)
+			if tt.wantErr != (nil != err) {
+				t.Fatalf("NewSigner() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantNil != (nil == s) {
+				t.Fatalf("NewSigner() = %v, wantNil %v", s, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestLoadSecret(t *testing.T) {
+	t.Setenv("TEST_VENDOR_SECRET", "from-env")
+	file := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(file, []byte("from-file\n"), 0600); nil != err {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		env     string
+		file    string
+		want    string
+		wantErr bool
+	}{
+		{"明文", "inline", "", "", "inline", false},
+		{"环境变量优先于明文", "inline", "TEST_VENDOR_SECRET", "", "from-env", false},
+		{"环境变量未设置", "inline", "TEST_VENDOR_UNSET", "", "", false},
+		{"密钥文件优先", "inline", "TEST_VENDOR_SECRET", file, "from-file", false},
+		{"密钥文件不存在", "inline", "TEST_VENDOR_SECRET", file + ".missing", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadSecret(tt.value, tt.env, tt.file)
+			if tt.wantErr != (nil != err) {
+				t.Fatalf("loadSecret() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.want != got {
+				t.Fatalf("loadSecret() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewVendorConfig(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "secret.missing")
+	c := &conf.Vendors{
+		Profile: "prod",
+		Ispay: map[string]*conf.Vendors_ISPay{
+			"prod":    {BaseUrl: "https://ispay.test/prod-api", SignKey: "key"},
+			"sandbox": {BaseUrl: "https://ispay.test/dev-api", SignKeyFile: missing},
+		},
+		Interlace: map[string]*conf.Vendors_Interlace{
+			"sandbox": {BaseUrl: "https://interlace.test/open-api/v3", ClientSecretFile: missing},
+		},
+	}
+
+	ispay, err := NewISPayConfig(c)
+	if nil != err || "https://ispay.test/prod-api" != ispay.HolderBaseURL || "key" != ispay.SignKey {
+		t.Fatalf("NewISPayConfig(prod) = %v, %v", ispay, err)
+	}
+
+	// 当前环境没配置的不回落到其他环境，启动时报错
+	if _, err = NewInterlaceConfig(c); nil == err {
+		t.Fatal("NewInterlaceConfig(prod) err = nil")
+	}
+
+	c.Profile = "sandbox"
+	if _, err = NewISPayConfig(c); nil == err {
+		t.Fatal("NewISPayConfig(sandbox) err = nil")
+	}
+	if _, err = NewInterlaceConfig(c); nil == err {
+		t.Fatal("NewInterlaceConfig(sandbox) err = nil")
+	}
+
+	c.Profile = "test"
+	if _, err = NewISPayConfig(c); nil == err {
+		t.Fatal("NewISPayConfig(test) err = nil")
+	}
+}
@@ -4,33 +4,52 @@ import (
 	pb "cardbinance/api/user/v1"
 	"cardbinance/internal/biz"
 	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/gas"
+	"cardbinance/internal/pkg/middleware/auth"
+	"cardbinance/internal/pkg/money"
+	"cardbinance/internal/pkg/notify"
+	"cardbinance/internal/pkg/rpcpool"
+	"cardbinance/internal/pkg/scheduler"
+	"cardbinance/internal/pkg/signer"
 	"context"
-	"crypto/ecdsa"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	transporthttp "github.com/go-kratos/kratos/v2/transport/http"
+	jwt2 "github.com/golang-jwt/jwt/v5"
 	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 type UserService struct {
 	pb.UnimplementedUserServer
 
-	uuc *biz.UserUseCase
-	log *log.Helper
-	ca  *conf.Auth
+	uuc     *biz.UserUseCase
+	log     *log.Helper
+	ca      *conf.Auth
+	cc      *conf.Chain
+	pool    rpcpool.Backend
+	sources DepositSources
+	jobs    *scheduler.Scheduler
+	signer  signer.Signer
+	notify  notify.Notifier
 }
 
-func NewUserService(uuc *biz.UserUseCase, logger log.Logger, ca *conf.Auth) *UserService {
-	return &UserService{uuc: uuc, log: log.NewHelper(logger), ca: ca}
+func NewUserService(uuc *biz.UserUseCase, logger log.Logger, ca *conf.Auth, cc *conf.Chain, pool rpcpool.Backend, sources DepositSources, cs *conf.Scheduler, jobs *scheduler.Scheduler, s signer.Signer, n notify.Notifier) *UserService {
+	u := &UserService{uuc: uuc, log: log.NewHelper(logger), ca: ca, cc: cc, pool: pool, sources: sources, jobs: jobs, signer: s, notify: n}
+	u.registerJobs(cs)
+	return u
 }
 
 // OpenCardHandle 废弃
@@ -79,129 +98,59 @@ func (u *UserService) CardStatusHandle(ctx context.Context, req *pb.CardStatusHa
 	return nil, nil
 }
 
-// RewardCardTwo 实体卡分红
+// RewardCardTwo 实体卡分红，由定时任务执行，这里只触发一次
 func (u *UserService) RewardCardTwo(ctx context.Context, req *pb.RewardCardTwoRequest) (*pb.RewardCardTwoReply, error) {
-	end := time.Now().UTC().Add(50 * time.Second)
-
-	var (
-		err error
-	)
-	for i := 1; i <= 10; i++ {
-		now := time.Now().UTC()
-		if end.Before(now) {
-			break
-		}
-
-		err = u.uuc.CardTwoStatusHandle(ctx)
-		if nil != err {
-			fmt.Println(err)
-		}
-		time.Sleep(5 * time.Second)
-	}
-
+	u.triggerJob("reward_card_two")
 	return nil, nil
 }
 
-// Deposit 充值
+// Deposit 充值，由定时任务执行，这里只触发一次
 func (u *UserService) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositReply, error) {
-	end := time.Now().UTC().Add(50 * time.Second)
-
-	for i := 1; i <= 10; i++ {
-		var (
-			depositUsdtResult []*userDeposit
-			depositUsers      map[string]*biz.User
-			fromAccount       []string
-			userLength        int64
-			last              int64
-			err               error
-		)
-
-		last, err = u.uuc.GetEthUserRecordLast()
-		if nil != err {
-			fmt.Println(err)
-			continue
-		}
-
-		if -1 == last {
-			fmt.Println(err)
-			continue
-		}
+	u.triggerJob("deposit")
+	return nil, nil
+}
 
-		// 0x0299e92df88c034F6425e78b6f6A367e84160B45 test
-		// 0x5d4bAA2A7a73dEF7685d036AAE993662B0Ef2f8F rel
-		userLength, err = getUserLength("0x27De677DBe07338C6bdB6BaB6BFbac6aF4Ea5b65")
-		if nil != err {
-			fmt.Println(err)
-		}
+// depositJob 每个充值来源各自扫描区块
+func (u *UserService) depositJob(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for _, v := range u.sources {
+		wg.Add(1)
+		go func(source *DepositSource) {
+			defer wg.Done()
+			u.depositSource(ctx, source)
+		}(v)
+	}
+	wg.Wait()
 
-		if -1 == userLength {
-			continue
-		}
+	return nil
+}
 
-		if 0 == userLength {
-			break
-		}
+// depositSource 扫描到确认高度或超时为止
+func (u *UserService) depositSource(ctx context.Context, source *DepositSource) {
+	var (
+		scanner *depositScanner
+		more    bool
+		err     error
+	)
 
-		if last >= userLength {
-			break
-		}
+	scanner, err = newDepositScanner(source)
+	if nil != err {
+		fmt.Println(source.Conf.Name, err)
+		return
+	}
 
-		// 0x0299e92df88c034F6425e78b6f6A367e84160B454 test
-		// 0x5d4bAA2A7a73dEF7685d036AAE993662B0Ef2f8F rel
-		depositUsdtResult, err = getUserInfo(last, userLength-1, "0x27De677DBe07338C6bdB6BaB6BFbac6aF4Ea5b65")
+	for nil == ctx.Err() {
+		more, err = u.indexDeposits(ctx, scanner)
 		if nil != err {
-			break
-		}
-
-		now := time.Now().UTC()
-		//fmt.Println(now, end)
-		if end.Before(now) {
-			break
-		}
-
-		if 0 >= len(depositUsdtResult) {
-			break
-		}
-
-		for _, vUser := range depositUsdtResult {
-			fromAccount = append(fromAccount, vUser.Address)
+			fmt.Println(source.Conf.Name, err)
+			return
 		}
 
-		depositUsers, err = u.uuc.GetUserByAddress(fromAccount...)
-		if nil != depositUsers {
-			// 统计开始
-			for _, vUser := range depositUsdtResult { // 主查usdt
-				if _, ok := depositUsers[vUser.Address]; !ok { // 用户不存在
-					continue
-				}
-
-				var (
-					tmpValue int64
-				)
-
-				if 10 <= vUser.Amount {
-					tmpValue = vUser.Amount
-				} else {
-					return &pb.DepositReply{}, nil
-				}
-
-				// 充值
-				err = u.uuc.DepositNew(ctx, depositUsers[vUser.Address].ID, uint64(tmpValue), &biz.EthUserRecord{ // 两种币的记录
-					UserId:    int64(depositUsers[vUser.Address].ID),
-					Amount:    strconv.FormatInt(tmpValue, 10) + "00000000000000000000",
-					AmountTwo: uint64(vUser.Amount),
-					Last:      userLength,
-				}, false)
-				if nil != err {
-					fmt.Println(err)
-				}
-			}
+		// 已追上确认高度，等下次执行
+		if !more {
+			return
 		}
-
-		time.Sleep(5 * time.Second)
 	}
-
-	return nil, nil
 }
 
 func FloatTo18DecimalsString(f float64) string {
@@ -229,96 +178,108 @@ func FloatTo18DecimalsString(f float64) string {
 	return integerPart + decimalPart
 }
 
-// AdminWithdrawEth 提现
+// AdminWithdrawEth 提现，由定时任务执行，这里只触发一次
 func (u *UserService) AdminWithdrawEth(ctx context.Context, req *pb.AdminWithdrawEthRequest) (*pb.AdminWithdrawEthReply, error) {
-	var (
-		withdraw     *biz.Withdraw
-		userIds      []uint64
-		userIdsMap   map[uint64]uint64
-		users        map[uint64]*biz.User
-		tokenAddress string
-		err          error
-	)
-	end := time.Now().UTC().Add(50 * time.Second)
+	u.triggerJob("withdraw")
+	return &pb.AdminWithdrawEthReply{}, nil
+}
 
-	for j := 1; j <= 10; j++ {
-		now := time.Now().UTC()
-		//fmt.Println(now, end)
-		if end.Before(now) {
-			break
-		}
+func (u *UserService) AdminLogin(ctx context.Context, req *pb.AdminLoginRequest) (*pb.AdminLoginReply, error) {
+	return u.uuc.AdminLogin(ctx, req, u.ca.JwtKey)
+}
 
-		withdraw, err = u.uuc.GetWithdrawPassOrRewardedFirst(ctx)
-		if nil == withdraw {
-			break
-		}
+func (u *UserService) AdminRewardList(ctx context.Context, req *pb.AdminRewardListRequest) (*pb.AdminRewardListReply, error) {
+	return u.uuc.AdminRewardList(ctx, req)
+}
 
-		userIdsMap = make(map[uint64]uint64, 0)
-		userIdsMap[withdraw.UserId] = withdraw.UserId
-		for _, v := range userIdsMap {
-			userIds = append(userIds, v)
-		}
+// Transfer 用户之间划转，转出用户取 token 里的
+func (u *UserService) Transfer(ctx context.Context, req *pb.TransferRequest) (*pb.TransferReply, error) {
+	userId, ok := userIdFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "请先登录")
+	}
 
-		users, err = u.uuc.GetUserByUserIds(userIds...)
-		if nil != err {
-			return nil, err
-		}
+	if nil == req.SendBody {
+		return nil, errors.New(500, "TRANSFER_ERROR", "参数错误")
+	}
 
-		if _, ok := users[withdraw.UserId]; !ok {
-			continue
-		}
+	amount, err := money.Parse(req.SendBody.Amount)
+	if nil != err {
+		return nil, errors.New(500, "TRANSFER_ERROR", "划转金额错误")
+	}
 
-		tokenAddress = "0x55d398326f99059fF775485246999027B3197955"
-		_, err = u.uuc.UpdateWithdrawDoing(ctx, withdraw.ID)
-		if nil != err {
-			continue
-		}
+	transfer, err := u.uuc.Transfer(ctx, userId, req.SendBody.Address, amount, req.SendBody.IdemKey)
+	if nil != err {
+		return nil, err
+	}
 
-		tmpUrl1 := "https://bsc-dataseed4.binance.org/"
-		withDrawAmount := FloatTo18DecimalsString(withdraw.RelAmount)
-		if len(withDrawAmount) <= 15 {
-			fmt.Println(withDrawAmount, withdraw)
-			_, err = u.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
-			continue
-		}
+	return &pb.TransferReply{
+		Id:        transfer.ID,
+		Amount:    transfer.Amount.String(),
+		Fee:       transfer.Fee.String(),
+		CreatedAt: transfer.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+	}, nil
+}
 
-		for i := 0; i <= 5; i++ {
-			//fmt.Println(11111, user.ToAddress, v.Amount, balanceInt)
-			_, err = toToken("", users[withdraw.UserId].Address, withDrawAmount, tokenAddress, tmpUrl1)
-			if err == nil {
-				_, err = u.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
-				//time.Sleep(3 * time.Second)
-				break
-			} else {
-				fmt.Println(err)
-				if 0 == i {
-					tmpUrl1 = "https://bsc-dataseed1.binance.org"
-				} else if 1 == i {
-					tmpUrl1 = "https://bsc-dataseed3.binance.org"
-				} else if 2 == i {
-					tmpUrl1 = "https://bsc-dataseed2.binance.org"
-				} else if 3 == i {
-					tmpUrl1 = "https://bnb-bscnews.rpc.blxrbdn.com/"
-				} else if 4 == i {
-					tmpUrl1 = "https://bsc-dataseed.binance.org"
-				}
-				fmt.Println(33331, err, users[withdraw.UserId].Address, withdraw.Address, withDrawAmount, tokenAddress)
-				time.Sleep(3 * time.Second)
-			}
-		}
+// Withdraw 用户提现，到账地址为绑定的钱包地址
+func (u *UserService) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*pb.WithdrawReply, error) {
+	userId, ok := userIdFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "请先登录")
+	}
 
-		time.Sleep(5 * time.Second)
+	if nil == req.SendBody {
+		return nil, errors.New(400, "PARAM_ERROR", "缺少参数")
 	}
 
-	return &pb.AdminWithdrawEthReply{}, nil
+	amount, err := money.Parse(req.SendBody.Amount)
+	if nil != err {
+		return nil, errors.New(500, "WITHDRAW_AMOUNT_ERROR", "提现金额错误")
+	}
+
+	users, err := u.uuc.GetUserByUserIds(userId)
+	if nil != err {
+		return nil, err
+	}
+
+	user, ok := users[userId]
+	if !ok {
+		return nil, errors.New(500, "USER_ERROR", "用户不存在")
+	}
+
+	withdraw, err := u.uuc.Withdraw(ctx, userId, amount, user.Address)
+	if nil != err {
+		return nil, err
+	}
+
+	return &pb.WithdrawReply{
+		Id:        withdraw.ID,
+		Amount:    withdraw.Amount.String(),
+		Fee:       withdraw.Fee.String(),
+		RelAmount: withdraw.RelAmount.String(),
+		Status:    withdraw.Status,
+		CreatedAt: withdraw.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+	}, nil
 }
 
-func (u *UserService) AdminLogin(ctx context.Context, req *pb.AdminLoginRequest) (*pb.AdminLoginReply, error) {
-	return u.uuc.AdminLogin(ctx, req, u.ca.JwtKey)
+// AdminBalanceDriftList 余额对账差异
+func (u *UserService) AdminBalanceDriftList(ctx context.Context, req *pb.AdminBalanceDriftListRequest) (*pb.AdminBalanceDriftListReply, error) {
+	return u.uuc.AdminBalanceDriftList(ctx, req)
 }
 
-func (u *UserService) AdminRewardList(ctx context.Context, req *pb.AdminRewardListRequest) (*pb.AdminRewardListReply, error) {
-	return u.uuc.AdminRewardList(ctx, req)
+// AdminTransferList 划转记录
+func (u *UserService) AdminTransferList(ctx context.Context, req *pb.AdminTransferListRequest) (*pb.AdminTransferListReply, error) {
+	return u.uuc.AdminTransferList(ctx, req)
+}
+
+// AdminUserStatement 用户对账单
+func (u *UserService) AdminUserStatement(ctx context.Context, req *pb.AdminUserStatementRequest) (*pb.AdminUserStatementReply, error) {
+	return u.uuc.AdminUserStatement(ctx, req)
+}
+
+// AdminRewardReasonList 收益原因和文案
+func (u *UserService) AdminRewardReasonList(ctx context.Context, req *pb.AdminRewardReasonListRequest) (*pb.AdminRewardReasonListReply, error) {
+	return u.uuc.AdminRewardReasonList(ctx, req)
 }
 
 func (u *UserService) AdminUserList(ctx context.Context, req *pb.AdminUserListRequest) (*pb.AdminUserListReply, error) {
@@ -365,6 +326,11 @@ func (u *UserService) SetVipThree(ctx context.Context, req *pb.SetVipThreeReques
 	return u.uuc.SetVipThree(ctx, req)
 }
 
+// SetLockTransfer 设置用户禁止划转
+func (u *UserService) SetLockTransfer(ctx context.Context, req *pb.SetLockTransferRequest) (*pb.SetLockTransferReply, error) {
+	return u.uuc.SetLockTransfer(ctx, req)
+}
+
 // SetUserCount 清除用户申请卡片次数，目前无用
 func (u *UserService) SetUserCount(ctx context.Context, req *pb.SetUserCountRequest) (*pb.SetUserCountReply, error) {
 	return u.uuc.SetUserCount(ctx, req)
@@ -384,26 +350,9 @@ func (u *UserService) AllInfo(ctx context.Context, req *pb.AllInfoRequest) (*pb.
 	return u.uuc.AllInfo(ctx, req)
 }
 
-// EmailGet 邮件转发
+// EmailGet 邮件转发，由定时任务执行，这里只触发一次
 func (u *UserService) EmailGet(ctx context.Context, req *pb.EmailGetRequest) (*pb.EmailGetReply, error) {
-	end := time.Now().UTC().Add(50 * time.Second)
-
-	var (
-		err error
-	)
-	for i := 1; i <= 10; i++ {
-		now := time.Now().UTC()
-		if end.Before(now) {
-			break
-		}
-
-		_, err = u.uuc.EmailGet(ctx, req)
-		if nil != err {
-			fmt.Println(err)
-		}
-		time.Sleep(5 * time.Second)
-	}
-
+	u.triggerJob("email")
 	return nil, nil
 }
 
@@ -416,6 +365,120 @@ type CallbackRequest struct {
 	Data      json.RawMessage `json:"data"` // 用 RawMessage 接收动态结构
 }
 
+// UserStatementCsv 用户对账单 CSV 导出，边查边写。
+// 不经过 kratos 中间件，后台 token 在这里验，只认 Authorization 头，不放在 query 里以免进访问日志
+func (u *UserService) UserStatementCsv(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	if !u.checkAdminToken(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	address := query.Get("address")
+	start := query.Get("start")
+	end := query.Get("end")
+	if _, _, err := biz.ParseStatementRange(start, end); nil != err {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var (
+		writer  *csv.Writer
+		flusher http.Flusher
+	)
+	started := false
+
+	// 表头后紧跟期初余额，每行余额都从它累加
+	_, closing, err := u.uuc.UserStatementByAddress(ctx, address, start, end, func(opening money.Money) error {
+		started = true
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"statement_%s_%s.csv\"", address, start))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("\xEF\xBB\xBF")) // excel 识别 utf-8
+
+		writer = csv.NewWriter(w)
+		flusher, _ = w.(http.Flusher)
+		_ = writer.Write([]string{"id", "时间", "原因编号", "原因", "关联", "备注", "金额", "余额"})
+		_ = writer.Write([]string{"", "", "", "期初余额", "", "", "", opening.String()})
+		return writer.Error()
+	}, func(lines []*biz.StatementLine) error {
+		for _, v := range lines {
+			if err := writer.Write([]string{
+				strconv.FormatUint(v.ID, 10),
+				v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+				strconv.FormatInt(int64(v.Reason), 10),
+				biz.RewardReasonLabel(v.Reason, "zh"),
+				v.Ref,
+				v.Remark,
+				v.Amount.String(),
+				v.Balance.String(),
+			}); nil != err {
+				return err
+			}
+		}
+
+		writer.Flush()
+		if nil != flusher {
+			flusher.Flush()
+		}
+
+		return writer.Error()
+	})
+	if nil != err {
+		if !started {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// 已经开始写了，只能截断
+		fmt.Println("对账单导出失败", address, start, end, err)
+		return
+	}
+
+	_ = writer.Write([]string{"", "", "", "期末余额", "", "", "", closing.String()})
+	writer.Flush()
+}
+
+// userIdFromContext 用户 token 里的用户 id，后台 token 不算
+func userIdFromContext(ctx context.Context) (uint64, bool) {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return 0, false
+	}
+
+	c, ok := claims.(jwt2.MapClaims)
+	if !ok || "user" != c["UserType"] {
+		return 0, false
+	}
+
+	userId, ok := c["UserId"].(float64)
+	if !ok || 0 >= userId {
+		return 0, false
+	}
+
+	return uint64(userId), true
+}
+
+// checkAdminToken 后台登录的 token
+func (u *UserService) checkAdminToken(tokenString string) bool {
+	if "" == tokenString {
+		return false
+	}
+
+	claims := &auth.CustomClaims{}
+	token, err := jwt2.ParseWithClaims(tokenString, claims, func(token *jwt2.Token) (interface{}, error) {
+		return []byte(u.ca.JwtKey), nil
+	}, jwt2.WithValidMethods([]string{jwt2.SigningMethodHS256.Alg()}))
+	if nil != err || !token.Valid {
+		return false
+	}
+
+	return "admin" == claims.UserType
+}
+
 // CallBack 废弃
 func (u *UserService) CallBack(w http.ResponseWriter, r *http.Request) {
 	// 从 http.Request 获取 context.Context
@@ -470,128 +533,58 @@ func (u *UserService) CallBack(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(`{"status":"ok"}`))
 }
 
-func getUserLength(address string) (int64, error) {
-	url1 := "https://bsc-dataseed4.binance.org/"
-
+func getUserLength(ctx context.Context, pool rpcpool.Backend, address string) (int64, error) {
 	var balInt int64
-	for i := 0; i < 5; i++ {
-		if 1 == i {
-			url1 = "https://binance.llamarpc.com/"
-		} else if 2 == i {
-			url1 = "https://bscrpc.com/"
-		} else if 3 == i {
-			url1 = "https://bsc-pokt.nodies.app/"
-		} else if 4 == i {
-			url1 = "https://data-seed-prebsc-1-s3.binance.org:8545/"
-		}
-
-		client, err := ethclient.Dial(url1)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		tokenAddress := common.HexToAddress(address)
-		instance, err := NewBuySomething(tokenAddress, client)
+	err := pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		instance, err := NewBuySomething(common.HexToAddress(address), client)
 		if err != nil {
-			fmt.Println(nil, err)
-			continue
+			return err
 		}
 
-		bals, err := instance.GetUserLength(&bind.CallOpts{})
+		bals, err := instance.GetUserLength(&bind.CallOpts{Context: ctx})
 		if err != nil {
-			fmt.Println(err)
-			//url1 = "https://bsc-dataseed4.binance.org"
-			continue
+			return err
 		}
 
 		balInt = bals.Int64()
-		break
+		return nil
+	})
+	if err != nil {
+		return -1, err
 	}
 
 	return balInt, nil
 }
 
 type userDeposit struct {
+	Index   int64
 	Address string
-	Amount  int64
+	Amount  *big.Int // 合约原始金额
 }
 
-func getUserInfo(start int64, end int64, address string) ([]*userDeposit, error) {
-	url1 := "https://bsc-dataseed4.binance.org/"
-
+func getUserInfo(ctx context.Context, pool rpcpool.Backend, start int64, end int64, address string) ([]*userDeposit, error) {
 	var (
 		bals  []common.Address
 		bals2 []*big.Int
 	)
 	users := make([]*userDeposit, 0)
 
-	for i := 0; i < 5; i++ {
-		if 1 == i {
-			url1 = "https://binance.llamarpc.com/"
-		} else if 2 == i {
-			url1 = "https://bscrpc.com/"
-		} else if 3 == i {
-			url1 = "https://bsc-pokt.nodies.app/"
-		} else if 4 == i {
-			url1 = "https://data-seed-prebsc-1-s3.binance.org:8545/"
-		}
-
-		client, err := ethclient.Dial(url1)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		tokenAddress := common.HexToAddress(address)
-		instance, err := NewBuySomething(tokenAddress, client)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		bals, err = instance.GetUsersByIndex(&bind.CallOpts{}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
+	err := pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		instance, err := NewBuySomething(common.HexToAddress(address), client)
 		if err != nil {
-			fmt.Println(err)
-			//url1 = "https://bsc-dataseed4.binance.org"
-			continue
+			return err
 		}
 
-		break
-	}
-
-	for i := 0; i < 5; i++ {
-		if 1 == i {
-			url1 = "https://binance.llamarpc.com/"
-		} else if 2 == i {
-			url1 = "https://bscrpc.com/"
-		} else if 3 == i {
-			url1 = "https://bsc-pokt.nodies.app/"
-		} else if 4 == i {
-			url1 = "https://data-seed-prebsc-1-s3.binance.org:8545/"
-		}
-
-		client, err := ethclient.Dial(url1)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		tokenAddress := common.HexToAddress(address)
-		instance, err := NewBuySomething(tokenAddress, client)
-		if err != nil {
-			fmt.Println(nil, err)
-			continue
-		}
-
-		bals2, err = instance.GetUsersAmountByIndex(&bind.CallOpts{}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
+		bals, err = instance.GetUsersByIndex(&bind.CallOpts{Context: ctx}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
 		if err != nil {
-			fmt.Println(err)
-			//url1 = "https://bsc-dataseed4.binance.org"
-			continue
+			return err
 		}
 
-		break
+		bals2, err = instance.GetUsersAmountByIndex(&bind.CallOpts{Context: ctx}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
+		return err
+	})
+	if err != nil {
+		return users, err
 	}
 
 	if len(bals) != len(bals2) {
@@ -601,76 +594,68 @@ func getUserInfo(start int64, end int64, address string) ([]*userDeposit, error)
 
 	for k, v := range bals {
 		users = append(users, &userDeposit{
+			Index:   start + int64(k),
 			Address: v.String(),
-			Amount:  bals2[k].Int64(),
+			Amount:  new(big.Int).Set(bals2[k]),
 		})
 	}
 
 	return users, nil
 }
 
-func toToken(userPrivateKey string, toAccount string, withdrawAmount string, withdrawTokenAddress string, url1 string) (string, error) {
-	client, err := ethclient.Dial(url1)
-	//client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
-	if err != nil {
-		return "", err
-	}
-
+// toToken 构造并签名代币转账，不广播
+func toToken(ctx context.Context, client rpcpool.Client, chainId int64, s signer.Signer, nonce uint64, strategy *gas.Strategy, price *gas.Price, toAccount string, withdrawAmount string, withdrawTokenAddress string) (*types.Transaction, error) {
 	tokenAddress := common.HexToAddress(withdrawTokenAddress)
 	instance, err := NewDfil(tokenAddress, client)
 	if err != nil {
 		fmt.Println(err)
-		return "", err
+		return nil, err
 	}
 
-	var authUser *bind.TransactOpts
+	tmpWithdrawAmount, _ := new(big.Int).SetString(withdrawAmount, 10)
 
-	var privateKey *ecdsa.PrivateKey
-	privateKey, err = crypto.HexToECDSA(userPrivateKey)
+	// 每笔转账单独估算用量
+	parsed, err := DfilMetaData.GetAbi()
 	if err != nil {
-		fmt.Println(err)
-		return "", err
+		return nil, err
 	}
 
-	//gasPrice, err := client.SuggestGasPrice(context.Background())
-	//if err != nil {
-	//	fmt.Println(err)
-	//	return "", err
-	//}
-
-	authUser, err = bind.NewKeyedTransactorWithChainID(privateKey, new(big.Int).SetInt64(56))
+	data, err := parsed.Pack("transfer", common.HexToAddress(toAccount), tmpWithdrawAmount)
 	if err != nil {
-		fmt.Println(err)
-		return "", err
+		return nil, err
 	}
 
-	tmpWithdrawAmount, _ := new(big.Int).SetString(withdrawAmount, 10)
-	_, err = instance.Transfer(&bind.TransactOpts{
-		From:     authUser.From,
-		Signer:   authUser.Signer,
-		GasLimit: 0,
-	}, common.HexToAddress(toAccount), tmpWithdrawAmount)
+	gasLimit, err := strategy.Limit(ctx, client, ethereum.CallMsg{From: s.Address(), To: &tokenAddress, Data: data})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return "", nil
+	opts := signer.TransactOpts(ctx, s, new(big.Int).SetInt64(chainId))
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+	opts.GasLimit = gasLimit
+	strategy.Apply(opts, price)
+	opts.NoSend = true
+	return instance.Transfer(opts, common.HexToAddress(toAccount), tmpWithdrawAmount)
 }
 
 // 实体卡
 func (u *UserService) UpdateAllCard(ctx context.Context, req *pb.UpdateAllCardRequest) (*pb.UpdateAllCardReply, error) {
-	return u.uuc.UpdateAllCard(ctx, req)
+	u.triggerJob("update_all_card")
+	return &pb.UpdateAllCardReply{}, nil
 }
 
 // 虚拟卡
 func (u *UserService) UpdateAllCardOne(ctx context.Context, req *pb.UpdateAllCardRequest) (*pb.UpdateAllCardReply, error) {
-	return u.uuc.UpdateAllCardTwo(ctx, req)
+	u.triggerJob("update_all_card_one")
+	return &pb.UpdateAllCardReply{}, nil
 }
 
 func (u *UserService) PullAllCard(ctx context.Context, req *pb.PullAllCardRequest) (*pb.PullAllCardReply, error) {
-	return u.uuc.PullAllCard(ctx, req)
+	u.triggerJob("pull_all_card")
+	return &pb.PullAllCardReply{}, nil
 }
 
 func (u *UserService) AutoUpdateAllCard(ctx context.Context, req *pb.UpdateAllCardRequest) (*pb.UpdateAllCardReply, error) {
-	return u.uuc.AutoUpdateAllCard(ctx, req)
+	u.triggerJob("auto_update_all_card")
+	return &pb.UpdateAllCardReply{}, nil
 }
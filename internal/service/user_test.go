@@ -0,0 +1,190 @@
+package service
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"cardbinance/internal/data"
+	"cardbinance/internal/pkg/middleware/auth"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"encoding/csv"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt2 "github.com/golang-jwt/jwt/v5"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUserStatementCsv(t *testing.T) {
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+	address := keyAddress(alice)
+
+	repo := newTestStore(t)
+	repo.addUser(1, address, "0")
+	day := time.Date(2026, 5, 1, 0, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	repo.postAt(biz.NewJournal(pb.RewardReason_REWARD_REASON_DEPOSIT, "a").Move(biz.AccountDeposit, biz.UserAccount(1), money.MustParse("100")), day.Add(-time.Hour))
+	repo.postAt(biz.NewJournal(pb.RewardReason_REWARD_REASON_DEPOSIT, "b").Move(biz.AccountDeposit, biz.UserAccount(1), money.MustParse("20")), day.Add(time.Hour))
+	repo.postAt(biz.NewJournal(pb.RewardReason_REWARD_REASON_WITHDRAW, "c").Move(biz.UserAccount(1), biz.AccountWithdraw, money.MustParse("5")), day.Add(2*time.Hour))
+
+	var ids []string
+	err := repo.db.Table("ledger_posting").
+		Joins("join ledger_account on ledger_account.id=ledger_posting.account_id").
+		Where("ledger_account.code=?", biz.UserAccount(1)).
+		Order("ledger_posting.id asc").Pluck("ledger_posting.id", &ids).Error
+	if nil != err || 3 != len(ids) {
+		t.Fatalf("postings = %v, %v", ids, err)
+	}
+	u := newTestService(t, chain, repo, nil)
+	u.ca = &conf.Auth{JwtKey: "test"}
+
+	token, err := auth.CreateToken(auth.CustomClaims{UserType: "admin"}, "test")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	url := "/api/admin_dhb/user_statement_csv?address=" + address + "&start=2026-05-01&end=2026-05-01"
+
+	// query 里的 token 不认
+	w := httptest.NewRecorder()
+	u.UserStatementCsv(w, httptest.NewRequest(http.MethodGet, url+"&token="+token, nil))
+	if http.StatusUnauthorized != w.Code {
+		t.Fatalf("query token status = %d", w.Code)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, url, nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	w = httptest.NewRecorder()
+	u.UserStatementCsv(w, r)
+	if http.StatusOK != w.Code {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+
+	rows, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(w.Body.String(), "\xEF\xBB\xBF"))).ReadAll()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	// 表头、期初、两笔分录、期末
+	if 5 != len(rows) {
+		t.Fatalf("rows = %v", rows)
+	}
+	if "期初余额" != rows[1][3] || "100" != rows[1][7] {
+		t.Fatalf("opening row = %v", rows[1])
+	}
+	if ids[1] != rows[2][0] || "120" != rows[2][7] || ids[2] != rows[3][0] || "115" != rows[3][7] {
+		t.Fatalf("lines = %v", rows[2:4])
+	}
+	if "期末余额" != rows[4][3] || "115" != rows[4][7] {
+		t.Fatalf("closing row = %v", rows[4])
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	alice, bob, carol := mustKey(t), mustKey(t), mustKey(t)
+	chain := newTestChain(t, alice)
+
+	repo := newTestStore(t)
+	repo.setConfig("transfer_daily_amount", "15")
+	repo.setConfig("transfer_fee_flat", "1")
+	repo.addUser(1, keyAddress(alice), "50")
+	repo.addUser(2, keyAddress(bob), "0")
+	repo.addUser(3, keyAddress(carol), "0")
+	u := newTestService(t, chain, repo, nil)
+	ctx := jwt.NewContext(context.Background(), jwt2.MapClaims{"UserType": "user", "UserId": float64(1)})
+
+	send := func(to, amount, idemKey string) (*pb.TransferReply, error) {
+		return u.Transfer(ctx, &pb.TransferRequest{SendBody: &pb.TransferRequest_SendBody{Address: to, Amount: amount, IdemKey: idemKey}})
+	}
+
+	res, err := send(keyAddress(bob), "10", "a")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if 1 != repo.locks[1] {
+		t.Fatalf("locks = %v", repo.locks)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "39")
+	assertMoney(t, "bob", repo.balance(biz.UserAccount(2)), "10")
+
+	// 同样的请求重放返回第一次的结果，不再扣款
+	replay, err := send(keyAddress(bob), "10", "a")
+	if nil != err || res.Id != replay.Id {
+		t.Fatalf("replay = %v, %v", replay, err)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "39")
+
+	// 请求编号相同但金额或收款人不同的拒绝
+	for _, tt := range []struct{ to, amount string }{{keyAddress(bob), "11"}, {keyAddress(carol), "10"}} {
+		if _, err = send(tt.to, tt.amount, "a"); "TRANSFER_IDEM_CONFLICT" != errors.Reason(err) {
+			t.Fatalf("conflict %v = %v", tt, err)
+		}
+	}
+
+	// 当天额度在锁住用户后判断
+	if _, err = send(keyAddress(bob), "6", "b"); "TRANSFER_LIMIT" != errors.Reason(err) {
+		t.Fatalf("limit = %v", err)
+	}
+	if 2 != repo.locks[1] {
+		t.Fatalf("locks = %v", repo.locks)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "39")
+}
+
+func TestReconcileBalances(t *testing.T) {
+	alice, bob := mustKey(t), mustKey(t)
+	chain := newTestChain(t, alice)
+
+	repo := newTestStore(t)
+	repo.addUser(1, keyAddress(alice), "30")
+	repo.addUser(2, keyAddress(bob), "0")
+	u := newTestService(t, chain, repo, nil)
+	ctx := context.Background()
+
+	// 上线记账前的充值和提现，已经算在余额里，和开户在同一秒
+	if err := repo.db.Table("reward").Create(&data.Reward{UserId: 1, Amount: money.MustParse("40"), Reason: pb.RewardReason_REWARD_REASON_DEPOSIT}).Error; nil != err {
+		t.Fatal(err)
+	}
+	if err := repo.db.Table("withdraw").Create(&data.Withdraw{ID: 1, UserId: 1, Amount: money.MustParse("10")}).Error; nil != err {
+		t.Fatal(err)
+	}
+
+	// 第一笔记账开户，之后的充值、提现、划转都要算
+	err := repo.tx.ExecTx(ctx, func(ctx context.Context) error {
+		err := repo.PostJournal(ctx, biz.NewJournal(pb.RewardReason_REWARD_REASON_DEPOSIT, "h").Move(biz.AccountDeposit, biz.UserAccount(1), money.MustParse("5")))
+		if nil != err {
+			return err
+		}
+
+		return repo.DepositCredit(ctx, 1, money.MustParse("5"), 1, "h")
+	})
+	if nil != err {
+		t.Fatal(err)
+	}
+	repo.addWithdraw(&biz.Withdraw{ID: 2, UserId: 1, Amount: money.MustParse("3"), Status: "rewarded"})
+
+	tctx := jwt.NewContext(ctx, jwt2.MapClaims{"UserType": "user", "UserId": float64(1)})
+	_, err = u.Transfer(tctx, &pb.TransferRequest{SendBody: &pb.TransferRequest_SendBody{Address: keyAddress(bob), Amount: "2", IdemKey: "a"}})
+	if nil != err {
+		t.Fatal(err)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "30")
+
+	_, drifts, err := u.uuc.ReconcileBalances(ctx)
+	if nil != err || 0 != len(drifts) {
+		t.Fatalf("drifts = %v, %v", drifts, err)
+	}
+
+	history, err := repo.GetUserBalanceHistory(ctx, 1)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if "30" != history.Opening.String() || "5" != history.Rewards[pb.RewardReason_REWARD_REASON_DEPOSIT].String() ||
+		"3" != history.Withdraw.String() || "2" != history.TransferOut.String() {
+		t.Fatalf("history = %s", history)
+	}
+}
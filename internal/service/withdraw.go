@@ -0,0 +1,651 @@
+package service
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/gas"
+	"cardbinance/internal/pkg/rpcpool"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultWithdrawToken BSC USDT
+const defaultWithdrawToken = "0x55d398326f99059fF775485246999027B3197955"
+
+// withdrawJob 批量领取待处理提现，多个协程并发签名广播，nonce 由本地分配
+func (u *UserService) withdrawJob(ctx context.Context) error {
+	var (
+		withdraws []*biz.Withdraw
+		userIds   []uint64
+		users     map[uint64]*biz.User
+		pending   uint64
+		err       error
+	)
+
+	if nil == u.signer {
+		return fmt.Errorf("未配置提现签名")
+	}
+
+	workers, batch, gasLimit := u.uuc.GetWithdrawSendConfig()
+	threshold := u.uuc.GetWithdrawReviewAmount()
+	strategy := gas.New(*u.uuc.GetWithdrawGasConfig())
+	from := u.signer.Address().Hex()
+
+	// 上次中断时领取了没发完的先恢复，再对齐 nonce
+	err = u.recoverWithdrawDoing(ctx, batch)
+	if nil != err {
+		return err
+	}
+
+	// 金额过小留到下次的，过期或已改为退回的退回余额
+	err = u.uuc.SweepWithdrawCarry(ctx, batch)
+	if nil != err {
+		return err
+	}
+
+	// 对齐链上 nonce，上次中断时分配了但没记录交易的 nonce 放回重用
+	err = u.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		var err error
+		pending, err = client.PendingNonceAt(ctx, u.signer.Address())
+		return err
+	})
+	if nil != err {
+		return err
+	}
+
+	err = u.uuc.SyncWithdrawNonce(ctx, from, pending)
+	if nil != err {
+		return err
+	}
+
+	// 超过审核金额的转待审核，审核通过的和自动发放的一起处理
+	err = u.uuc.RouteWithdrawReview(ctx, batch)
+	if nil != err {
+		return err
+	}
+
+	withdraws, err = u.uuc.GetWithdrawsByStatus("pass", batch)
+	if nil != err {
+		return err
+	}
+
+	if len(withdraws) < batch {
+		var rewarded []*biz.Withdraw
+		rewarded, err = u.uuc.GetWithdrawsByStatus("rewarded", batch-len(withdraws))
+		if nil != err {
+			return err
+		}
+
+		withdraws = append(withdraws, rewarded...)
+	}
+
+	for _, v := range withdraws {
+		userIds = append(userIds, v.UserId)
+	}
+
+	if 0 < len(userIds) {
+		users, err = u.uuc.GetUserByUserIds(userIds...)
+		if nil != err {
+			return err
+		}
+	}
+
+	// 用户已不存在的标记失败，不再占用每批的名额
+	tmpWithdraws := make([]*biz.Withdraw, 0, len(withdraws))
+	for _, v := range withdraws {
+		if _, ok := users[v.UserId]; ok {
+			tmpWithdraws = append(tmpWithdraws, v)
+			continue
+		}
+
+		fmt.Println("提现用户不存在", v.ID, v.UserId)
+		if errTwo := u.uuc.FailWithdraw(ctx, v, "提现用户不存在"); nil != errTwo {
+			fmt.Println("提现标记失败出错", v.ID, errTwo)
+		}
+	}
+	withdraws = tmpWithdraws
+
+	tokenAddress := u.cc.GetWithdrawToken()
+	if "" == tokenAddress {
+		tokenAddress = defaultWithdrawToken
+	}
+
+	// 本批统一定价，超过上限时网络拥堵，本次不发
+	var price *gas.Price
+	if 0 < len(withdraws) {
+		var priceErr error
+		err = u.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+			price, priceErr = strategy.Price(ctx, client)
+			if errors.Is(priceErr, gas.ErrPriceTooHigh) {
+				return nil
+			}
+			return priceErr
+		})
+		if nil != err {
+			return err
+		}
+
+		if nil != priceErr {
+			fmt.Println("gas价格超过上限，本次不发放", priceErr)
+			return nil
+		}
+	}
+
+	// 热钱包余额不够本批时暂停，不再发注定失败的交易，没有待发放的也检查，暂停中的可以及时恢复
+	ok, err := u.checkHotWallet(ctx, withdraws, tokenAddress, price, gasLimit)
+	if nil != err {
+		return err
+	}
+
+	if !ok || 0 >= len(withdraws) {
+		return nil
+	}
+
+	queue := make(chan *biz.Withdraw)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for withdraw := range queue {
+				// 超时后不再领取，留给下次执行
+				if nil != ctx.Err() {
+					continue
+				}
+
+				// 领取，并发或重复执行时只有一个成功
+				from := withdraw.Status
+				err := u.uuc.UpdateWithdrawStatus(ctx, withdraw.ID, from, "doing")
+				if nil != err {
+					continue
+				}
+
+				// 没审核过的发送前再按审核线判断一次，转审核失败的不发
+				if "pass" != from && biz.NeedWithdrawReview(withdraw, threshold) {
+					err = u.uuc.ReviewWithdrawDoing(ctx, withdraw)
+					if nil != err {
+						fmt.Println("提现转审核失败", withdraw.ID, err)
+						u.releaseWithdraw(withdraw.ID, from)
+					}
+					continue
+				}
+
+				// 金额过小不发交易，按配置退回或并入下次
+				withDrawAmount := biz.DecimalToWei(withdraw.RelAmount.String(), 18).String()
+				if len(withDrawAmount) <= 15 {
+					err = u.uuc.RejectWithdrawDust(ctx, withdraw)
+					if nil != err {
+						fmt.Println("金额过小提现处理失败", withdraw.ID, withDrawAmount, err)
+						u.releaseWithdraw(withdraw.ID, from)
+					}
+					continue
+				}
+
+				err = u.sendWithdraw(ctx, withdraw, users[withdraw.UserId].Address, withDrawAmount, tokenAddress, strategy, price)
+				if nil != err {
+					fmt.Println(33331, err, users[withdraw.UserId].Address, withdraw.Address, withDrawAmount, tokenAddress)
+					u.releaseWithdraw(withdraw.ID, from)
+				}
+			}
+		}()
+	}
+
+	for _, withdraw := range withdraws {
+		if nil != ctx.Err() {
+			break
+		}
+
+		queue <- withdraw
+	}
+	close(queue)
+	wg.Wait()
+
+	return nil
+}
+
+// releaseWithdraw 没发出去的退回领取前的状态，下次重新领取，超时取消后也要执行
+func (u *UserService) releaseWithdraw(id uint64, from string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := u.uuc.UpdateWithdrawStatus(ctx, id, "doing", from); nil != err {
+		fmt.Println("提现退回待处理失败", id, from, err)
+	}
+}
+
+// recoverWithdrawDoing 超过卡住时间仍是 doing 的，是进程在领取后中断留下的。
+// 已保存交易的按链上回执和 nonce 判断，交给 withdraw_watch 确认、退款或重发；
+// 没保存交易的还没发送过，退回 rewarded，重新按审核金额判断后发放
+func (u *UserService) recoverWithdrawDoing(ctx context.Context, limit int) error {
+	_, stuck, _ := u.uuc.GetWithdrawWatchConfig()
+	withdraws, err := u.uuc.GetWithdrawsByStatus("doing", limit)
+	if nil != err {
+		return err
+	}
+
+	for _, v := range withdraws {
+		if time.Since(v.UpdatedAt) < stuck {
+			continue
+		}
+
+		if "" == v.RawTx {
+			fmt.Println("提现处理中断，退回待处理", v.ID)
+			err = u.uuc.RecoverWithdrawDoing(ctx, v, "rewarded", "处理中断，重新发放")
+		} else {
+			err = u.recoverWithdrawTx(ctx, v)
+		}
+
+		if nil != err {
+			fmt.Println("提现处理中断恢复失败", v.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// recoverWithdrawTx 已保存签名交易的 doing，链上已有回执或 nonce 还没被用掉时补发一次，都转为 broadcast
+func (u *UserService) recoverWithdrawTx(ctx context.Context, withdraw *biz.Withdraw) error {
+	raw, err := hexutil.Decode(withdraw.RawTx)
+	if err != nil {
+		return err
+	}
+
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(raw); err != nil {
+		return err
+	}
+
+	err = u.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		_, err := client.TransactionReceipt(ctx, tx.Hash())
+		if nil == err {
+			return nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return err
+		}
+
+		nonce, err := client.NonceAt(ctx, u.signer.Address(), nil)
+		if err != nil {
+			return err
+		}
+
+		// nonce 已被其他交易用掉的由 withdraw_watch 标记失败并退款
+		if nonce > tx.Nonce() {
+			return nil
+		}
+
+		if errSend := client.SendTransaction(ctx, tx); nil != errSend {
+			fmt.Println("提现交易补发失败", withdraw.ID, tx.Hash().Hex(), errSend)
+		}
+		return nil
+	})
+	if nil != err {
+		return err
+	}
+
+	fmt.Println("提现处理中断，交易已保存，转为已广播", withdraw.ID, tx.Hash().Hex())
+	return u.uuc.RecoverWithdrawDoing(ctx, withdraw, "broadcast", "处理中断，按已保存交易跟踪")
+}
+
+// checkHotWallet 查询热钱包 USDT 和 BNB 余额，和本批提现金额、gas 比较，gas 按单价上限乘用量上限预留
+func (u *UserService) checkHotWallet(ctx context.Context, withdraws []*biz.Withdraw, tokenAddress string, price *gas.Price, gasLimit uint64) (bool, error) {
+	var (
+		usdt *big.Int
+		bnb  *big.Int
+	)
+
+	err := u.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		instance, err := NewDfil(common.HexToAddress(tokenAddress), client)
+		if err != nil {
+			return err
+		}
+
+		usdt, err = instance.BalanceOf(&bind.CallOpts{Context: ctx}, u.signer.Address())
+		if err != nil {
+			return err
+		}
+
+		bnb, err = client.BalanceAt(ctx, u.signer.Address(), nil)
+		return err
+	})
+	if nil != err {
+		return false, err
+	}
+
+	needUsdt := new(big.Int)
+	count := int64(0)
+	for _, v := range withdraws {
+		withDrawAmount := biz.DecimalToWei(v.RelAmount.String(), 18).String()
+		if len(withDrawAmount) <= 15 {
+			continue
+		}
+
+		tmp, ok := new(big.Int).SetString(withDrawAmount, 10)
+		if !ok {
+			continue
+		}
+
+		needUsdt.Add(needUsdt, tmp)
+		count++
+	}
+
+	needBnb := new(big.Int)
+	if nil != price {
+		gasPrice := price.GasPrice
+		if nil == gasPrice {
+			gasPrice = price.GasFeeCap
+		}
+
+		needBnb.Mul(gasPrice, new(big.Int).SetUint64(gasLimit))
+		needBnb.Mul(needBnb, big.NewInt(count))
+	}
+
+	return u.uuc.CheckWithdrawBalance(ctx, usdt, bnb, needUsdt, needBnb)
+}
+
+// sendWithdraw 分配 nonce、签名、记录后广播，未记录前失败的 nonce 放回
+func (u *UserService) sendWithdraw(ctx context.Context, withdraw *biz.Withdraw, toAccount string, amount string, tokenAddress string, strategy *gas.Strategy, price *gas.Price) error {
+	from := u.signer.Address().Hex()
+
+	// 节点池内换节点重试，交易签好先记录再广播，之后由 withdraw_watch 确认
+	return u.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		pending, err := client.PendingNonceAt(ctx, u.signer.Address())
+		if err != nil {
+			return err
+		}
+
+		nonce, err := u.uuc.AllocateWithdrawNonce(ctx, from, pending)
+		if err != nil {
+			return err
+		}
+
+		tx, err := toToken(ctx, client, u.cc.ChainId, u.signer, nonce, strategy, price, toAccount, amount, tokenAddress)
+		if err == nil {
+			err = u.broadcastWithdraw(ctx, client, withdraw, tx)
+		}
+
+		if err != nil {
+			if errRelease := u.uuc.ReleaseWithdrawNonce(ctx, from, nonce); nil != errRelease {
+				fmt.Println("提现nonce放回失败", from, nonce, errRelease)
+			}
+			return err
+		}
+
+		return nil
+	})
+}
+
+// AdminWithdrawList 提现列表
+func (u *UserService) AdminWithdrawList(ctx context.Context, req *pb.AdminWithdrawListRequest) (*pb.AdminWithdrawListReply, error) {
+	return u.uuc.AdminWithdrawList(ctx, req)
+}
+
+// AdminWithdrawPause 提现队列暂停状态
+func (u *UserService) AdminWithdrawPause(ctx context.Context, req *pb.AdminWithdrawPauseRequest) (*pb.AdminWithdrawPauseReply, error) {
+	return u.uuc.AdminWithdrawPause(ctx, req)
+}
+
+// AdminWithdrawReview 提现审核
+func (u *UserService) AdminWithdrawReview(ctx context.Context, req *pb.AdminWithdrawReviewRequest) (*pb.AdminWithdrawReviewReply, error) {
+	return u.uuc.AdminWithdrawReview(ctx, req)
+}
+
+// broadcastWithdraw 保存签好的交易后再发送，发送失败由 withdraw_watch 按卡住处理重发
+func (u *UserService) broadcastWithdraw(ctx context.Context, client rpcpool.Client, withdraw *biz.Withdraw, tx *types.Transaction) error {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	withdraw.TxHash = tx.Hash().Hex()
+	withdraw.Nonce = tx.Nonce()
+	withdraw.GasPrice = tx.GasPrice().String()
+	withdraw.GasLimit = tx.Gas()
+	withdraw.Rpc = rpcpool.Endpoint(ctx)
+	withdraw.Attempts++
+	withdraw.RawTx = hexutil.Encode(raw)
+	withdraw.BroadcastAt = time.Now()
+	err = u.uuc.UpdateWithdrawBroadcast(ctx, withdraw)
+	if err != nil {
+		return err
+	}
+
+	err = client.SendTransaction(ctx, tx)
+	if err != nil {
+		fmt.Println("提现交易广播失败", withdraw.ID, withdraw.TxHash, err)
+	}
+
+	return nil
+}
+
+// withdrawWatchJob 查已广播提现的回执，确认、失败或重发卡住的交易
+func (u *UserService) withdrawWatchJob(ctx context.Context) error {
+	var (
+		withdraws []*biz.Withdraw
+		err       error
+	)
+
+	if nil == u.signer {
+		return fmt.Errorf("未配置提现签名")
+	}
+
+	confirmations, stuck, maxAttempts := u.uuc.GetWithdrawWatchConfig()
+	withdraws, err = u.uuc.GetWithdrawsBroadcast(100)
+	if nil != err {
+		return err
+	}
+
+	for _, v := range withdraws {
+		if nil != ctx.Err() {
+			break
+		}
+
+		err = u.watchWithdraw(ctx, v, confirmations, stuck, maxAttempts)
+		if nil != err {
+			fmt.Println("提现交易检查失败", v.ID, v.TxHash, err)
+		}
+	}
+
+	return nil
+}
+
+func (u *UserService) watchWithdraw(ctx context.Context, withdraw *biz.Withdraw, confirmations uint64, stuck time.Duration, maxAttempts uint64) error {
+	var (
+		receipt *types.Receipt
+		head    uint64
+		nonce   uint64
+	)
+
+	err := u.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		// 先查 nonce 再查回执，避免两次查询之间上链被误判为 nonce 被占用
+		var err error
+		nonce, err = client.NonceAt(ctx, u.signer.Address(), nil)
+		if err != nil {
+			return err
+		}
+
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return err
+		}
+		head = header.Number.Uint64()
+
+		receipt = nil
+		for _, hash := range withdraw.Hashes() {
+			tmp, err := client.TransactionReceipt(ctx, common.HexToHash(hash))
+			if errors.Is(err, ethereum.NotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			receipt = tmp
+			break
+		}
+
+		return nil
+	})
+	if nil != err {
+		return err
+	}
+
+	if nil != receipt {
+		// 回滚的交易绝不能算成功
+		if types.ReceiptStatusSuccessful != receipt.Status {
+			fmt.Println("提现交易执行失败", withdraw.ID, receipt.TxHash.Hex())
+			return u.uuc.UpdateWithdrawFailed(ctx, withdraw, receipt.GasUsed, u.gasCost(ctx, receipt))
+		}
+
+		if receipt.BlockNumber.Uint64()+confirmations <= head {
+			return u.uuc.UpdateWithdrawConfirmed(ctx, withdraw.ID, receipt.GasUsed, u.gasCost(ctx, receipt))
+		}
+
+		return nil
+	}
+
+	if time.Since(withdraw.BroadcastAt) < stuck {
+		return nil
+	}
+
+	// 同一 nonce 已被其他交易使用，这笔不会再上链
+	if nonce > withdraw.Nonce {
+		fmt.Println("提现交易nonce已被占用", withdraw.ID, withdraw.Nonce, nonce)
+		return u.uuc.UpdateWithdrawFailed(ctx, withdraw, 0, "")
+	}
+
+	if withdraw.Attempts >= maxAttempts {
+		fmt.Println("提现交易重发次数已满，需人工处理", withdraw.ID, withdraw.TxHash)
+		return nil
+	}
+
+	return u.rebroadcastWithdraw(ctx, withdraw)
+}
+
+// gasCost 回执对应交易的实际 gas 费，查询失败时记空，不影响状态更新
+func (u *UserService) gasCost(ctx context.Context, receipt *types.Receipt) string {
+	var res string
+
+	err := u.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		tx, _, err := client.TransactionByHash(ctx, receipt.TxHash)
+		if err != nil {
+			return err
+		}
+
+		header, err := client.HeaderByNumber(ctx, receipt.BlockNumber)
+		if err != nil {
+			return err
+		}
+
+		res = gas.Cost(tx, receipt.GasUsed, header.BaseFee).String()
+		return nil
+	})
+	if nil != err {
+		fmt.Println("提现gas费查询失败", receipt.TxHash.Hex(), err)
+	}
+
+	return res
+}
+
+// rebroadcastWithdraw 同一 nonce 提高 gas 价格重发，已到单价上限的不再提价，等待上链
+func (u *UserService) rebroadcastWithdraw(ctx context.Context, withdraw *biz.Withdraw) error {
+	raw, err := hexutil.Decode(withdraw.RawTx)
+	if err != nil {
+		return err
+	}
+
+	old := new(types.Transaction)
+	if err = old.UnmarshalBinary(raw); err != nil {
+		return err
+	}
+
+	strategy := gas.New(*u.uuc.GetWithdrawGasConfig())
+	return u.pool.Do(ctx, func(ctx context.Context, client rpcpool.Client) error {
+		suggest, err := client.SuggestGasPrice(ctx)
+		if err != nil {
+			return err
+		}
+
+		bumped, ok := bumpGasPrice(old, suggest, strategy)
+		if !ok {
+			fmt.Println("提现交易gas已到上限，不再提价", withdraw.ID, withdraw.TxHash)
+			return nil
+		}
+
+		tx, err := u.signer.SignTx(ctx, bumped, new(big.Int).SetInt64(u.cc.ChainId))
+		if err != nil {
+			return err
+		}
+
+		if "" == withdraw.TxHistory {
+			withdraw.TxHistory = withdraw.TxHash
+		} else if !strings.Contains(withdraw.TxHistory, withdraw.TxHash) {
+			withdraw.TxHistory += "," + withdraw.TxHash
+		}
+
+		fmt.Println("提现交易重发", withdraw.ID, withdraw.TxHash, tx.Hash().Hex(), tx.GasPrice().String())
+		return u.broadcastWithdraw(ctx, client, withdraw, tx)
+	})
+}
+
+// bumpGasPrice 价格至少提高 25%，且不低于当前建议价，不超过单价上限
+func bumpGasPrice(old *types.Transaction, suggest *big.Int, strategy *gas.Strategy) (*types.Transaction, bool) {
+	bump := func(price *big.Int, floor *big.Int) *big.Int {
+		res := new(big.Int).Mul(price, big.NewInt(125))
+		res.Quo(res, big.NewInt(100))
+		if nil != floor && 0 < floor.Cmp(res) {
+			res.Set(floor)
+		}
+
+		return res
+	}
+
+	if types.DynamicFeeTxType == old.Type() {
+		feeCap, ok := strategy.Cap(bump(old.GasFeeCap(), suggest), old.GasFeeCap())
+		if !ok {
+			return nil, false
+		}
+
+		tipCap := bump(old.GasTipCap(), nil)
+		if 0 < tipCap.Cmp(feeCap) {
+			tipCap.Set(feeCap)
+		}
+
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   old.ChainId(),
+			Nonce:     old.Nonce(),
+			GasTipCap: tipCap,
+			GasFeeCap: feeCap,
+			Gas:       old.Gas(),
+			To:        old.To(),
+			Value:     old.Value(),
+			Data:      old.Data(),
+		}), true
+	}
+
+	gasPrice, ok := strategy.Cap(bump(old.GasPrice(), suggest), old.GasPrice())
+	if !ok {
+		return nil, false
+	}
+
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    old.Nonce(),
+		GasPrice: gasPrice,
+		Gas:      old.Gas(),
+		To:       old.To(),
+		Value:    old.Value(),
+		Data:     old.Data(),
+	}), true
+}
@@ -0,0 +1,432 @@
+package service
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/money"
+	"cardbinance/internal/pkg/signer"
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt2 "github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestWithdrawJob(t *testing.T) {
+	ctx := context.Background()
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+
+	repo := newTestStore(t)
+	repo.setConfig("withdraw_workers", "1")
+	repo.setConfig("withdraw_confirmations", "0")
+	repo.addUser(1, keyAddress(alice), "50")
+	repo.addWithdraw(&biz.Withdraw{ID: 1, UserId: 1, Amount: money.MustParse("10"), Fee: money.MustParse("1"), RelAmount: money.MustParse("9"), Status: "rewarded", Address: keyAddress(alice)})
+	repo.addWithdraw(&biz.Withdraw{ID: 2, UserId: 1, Amount: money.MustParse("0.0002"), Fee: money.MustParse("0.0001"), RelAmount: money.MustParse("0.0001"), Status: "rewarded", Address: keyAddress(alice)})
+	u := newTestService(t, chain, repo, nil)
+
+	if err := u.withdrawJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	w := repo.withdraw(1)
+	if "broadcast" != w.Status || "" == w.TxHash || "" == w.RawTx || 2 != w.Nonce {
+		t.Fatalf("withdraw 1 = %+v", w)
+	}
+
+	// 金额过小的不发交易，默认退回余额
+	w = repo.withdraw(2)
+	if "rejected_dust" != w.Status || "refunded" != w.Dust || 1 != repo.refunds(2) {
+		t.Fatalf("withdraw 2 = %+v", w)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "40")
+	assertMoney(t, "fee", repo.balance(biz.AccountFee), "1")
+
+	chain.backend.Commit()
+	if err := u.withdrawWatchJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	w = repo.withdraw(1)
+	if "confirmed" != w.Status || 0 >= w.GasUsed || "" == w.GasCost {
+		t.Fatalf("withdraw 1 = %+v", w)
+	}
+
+	got := chain.tokenBalance(t, common.HexToAddress(keyAddress(alice)))
+	if "9000000000000000000" != got.String() {
+		t.Fatalf("token balance = %s", got)
+	}
+
+	// 再次执行没有待处理的，不重复发放
+	if err := u.withdrawJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+	chain.backend.Commit()
+	if got = chain.tokenBalance(t, common.HexToAddress(keyAddress(alice))); "9000000000000000000" != got.String() {
+		t.Fatalf("token balance = %s", got)
+	}
+}
+
+func TestWithdrawJobPausesWithoutBalance(t *testing.T) {
+	ctx := context.Background()
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+
+	repo := newTestStore(t)
+	repo.addUser(1, keyAddress(alice), "2000000")
+	repo.addWithdraw(&biz.Withdraw{ID: 1, UserId: 1, Amount: money.MustParse("1000001"), RelAmount: money.MustParse("1000001"), Status: "rewarded", Address: keyAddress(alice)})
+	u := newTestService(t, chain, repo, nil)
+
+	if err := u.withdrawJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	if w := repo.withdraw(1); "rewarded" != w.Status {
+		t.Fatalf("withdraw 1 = %+v", w)
+	}
+
+	pause, _ := repo.GetWithdrawPause(ctx)
+	if nil == pause || !pause.Paused {
+		t.Fatalf("pause = %+v", pause)
+	}
+}
+
+func TestWithdrawWatchFailedRefunds(t *testing.T) {
+	ctx := context.Background()
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+
+	// alice 没有代币，固定 gas 发出的转账上链后 revert
+	instance, err := NewDfil(chain.token, chain.backend)
+	if nil != err {
+		t.Fatal(err)
+	}
+	opts := chain.transactor(t, alice)
+	opts.GasLimit = 100000
+	reverted, err := instance.Transfer(opts, common.HexToAddress(keyAddress(alice)), big.NewInt(1))
+	if nil != err {
+		t.Fatal(err)
+	}
+	chain.backend.Commit()
+
+	repo := newTestStore(t)
+	repo.setConfig("withdraw_confirmations", "0")
+	repo.addUser(1, keyAddress(alice), "50")
+	repo.addWithdraw(&biz.Withdraw{ID: 1, UserId: 1, Amount: money.MustParse("10"), Fee: money.MustParse("1"), RelAmount: money.MustParse("9"), Status: "broadcast", TxHash: reverted.Hash().Hex(), BroadcastAt: time.Now()})
+	// nonce 0 已被部署合约用掉，这笔不会再上链
+	repo.addWithdraw(&biz.Withdraw{ID: 2, UserId: 1, Amount: money.MustParse("5"), RelAmount: money.MustParse("5"), Status: "broadcast", TxHash: common.HexToHash("0x01").Hex(), Nonce: 0, BroadcastAt: time.Now().Add(-time.Hour)})
+	u := newTestService(t, chain, repo, nil)
+
+	if err = u.withdrawWatchJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	w := repo.withdraw(1)
+	if "failed" != w.Status || 0 >= w.GasUsed || 1 != repo.refunds(1) {
+		t.Fatalf("withdraw 1 = %+v", w)
+	}
+
+	w = repo.withdraw(2)
+	if "failed" != w.Status || 1 != repo.refunds(2) {
+		t.Fatalf("withdraw 2 = %+v", w)
+	}
+
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "50")
+	assertMoney(t, "withdraw", repo.balance(biz.AccountWithdraw), "0")
+	assertMoney(t, "fee", repo.balance(biz.AccountFee), "0")
+
+	// 已是 failed 的不再处理，不重复退款
+	if err = u.withdrawWatchJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if 1 != repo.refunds(1) || 1 != repo.refunds(2) {
+		t.Fatalf("refunds = %d, %d", repo.refunds(1), repo.refunds(2))
+	}
+}
+
+// failSigner 签名服务不可用
+type failSigner struct {
+	signer.Signer
+}
+
+func (failSigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	return nil, fmt.Errorf("signer unavailable")
+}
+
+func TestWithdrawJobReleasesOnSendFailure(t *testing.T) {
+	ctx := context.Background()
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+
+	repo := newTestStore(t)
+	repo.setConfig("withdraw_workers", "1")
+	repo.addUser(1, keyAddress(alice), "50")
+	repo.addWithdraw(&biz.Withdraw{ID: 1, UserId: 1, Amount: money.MustParse("10"), RelAmount: money.MustParse("10"), Status: "pass", Address: keyAddress(alice)})
+	u := newTestService(t, chain, repo, nil)
+	u.signer = failSigner{Signer: chain.hot}
+
+	if err := u.withdrawJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	// 退回领取前的状态，分配的 nonce 放回
+	if w := repo.withdraw(1); "pass" != w.Status {
+		t.Fatalf("withdraw 1 = %+v", w)
+	}
+	if free := repo.nonceFree(chain.hot.Address().Hex()); 1 != len(free) || "2" != free[0] {
+		t.Fatalf("nonce free = %v", free)
+	}
+
+	// 签名恢复后正常发放，用同一个 nonce
+	u.signer = chain.hot
+	if err := u.withdrawJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if w := repo.withdraw(1); "broadcast" != w.Status || 2 != w.Nonce {
+		t.Fatalf("withdraw 1 = %+v", w)
+	}
+}
+
+func TestWithdrawJobRecoversDoing(t *testing.T) {
+	ctx := context.Background()
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+	to := common.HexToAddress(keyAddress(alice))
+
+	// 中断前已保存但没发出的交易
+	instance, err := NewDfil(chain.token, chain.backend)
+	if nil != err {
+		t.Fatal(err)
+	}
+	opts := chain.transactor(t, chain.hotKey)
+	opts.Nonce = big.NewInt(2)
+	opts.GasLimit = 100000
+	opts.NoSend = true
+	saved, err := instance.Transfer(opts, to, big.NewInt(3000000000000000000))
+	if nil != err {
+		t.Fatal(err)
+	}
+	raw, err := saved.MarshalBinary()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	repo := newTestStore(t)
+	repo.setConfig("withdraw_workers", "1")
+	repo.setConfig("withdraw_confirmations", "0")
+	repo.addUser(1, keyAddress(alice), "50")
+	old := time.Now().Add(-time.Hour)
+	repo.addWithdraw(&biz.Withdraw{ID: 1, UserId: 1, Amount: money.MustParse("3"), RelAmount: money.MustParse("3"), Status: "doing", TxHash: saved.Hash().Hex(), Nonce: 2, RawTx: hexutil.Encode(raw), BroadcastAt: time.Now(), UpdatedAt: old})
+	// 中断前还没签名
+	repo.addWithdraw(&biz.Withdraw{ID: 2, UserId: 1, Amount: money.MustParse("4"), RelAmount: money.MustParse("4"), Status: "doing", Address: keyAddress(alice), UpdatedAt: old})
+	// 刚领取的可能还在处理，不动
+	repo.addWithdraw(&biz.Withdraw{ID: 3, UserId: 1, Amount: money.MustParse("5"), RelAmount: money.MustParse("5"), Status: "doing", Address: keyAddress(alice), UpdatedAt: time.Now()})
+	u := newTestService(t, chain, repo, nil)
+
+	if err = u.withdrawJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	if w := repo.withdraw(1); "broadcast" != w.Status {
+		t.Fatalf("withdraw 1 = %+v", w)
+	}
+	// 退回 rewarded 后本次直接发放，nonce 接在已保存的交易后面
+	if w := repo.withdraw(2); "broadcast" != w.Status || 3 != w.Nonce {
+		t.Fatalf("withdraw 2 = %+v", w)
+	}
+	if w := repo.withdraw(3); "doing" != w.Status {
+		t.Fatalf("withdraw 3 = %+v", w)
+	}
+
+	chain.backend.Commit()
+	if err = u.withdrawWatchJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	for _, id := range []uint64{1, 2} {
+		if w := repo.withdraw(id); "confirmed" != w.Status {
+			t.Fatalf("withdraw %d = %+v", id, w)
+		}
+	}
+	if got := chain.tokenBalance(t, to); "7000000000000000000" != got.String() {
+		t.Fatalf("token balance = %s", got)
+	}
+}
+
+func TestWithdrawJobReviewThreshold(t *testing.T) {
+	ctx := context.Background()
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+
+	repo := newTestStore(t)
+	repo.setConfig("withdraw_workers", "1")
+	repo.setConfig("withdraw_review_amount", "5")
+	repo.addUser(1, keyAddress(alice), "50")
+	repo.addWithdraw(&biz.Withdraw{ID: 1, UserId: 1, Amount: money.MustParse("6"), RelAmount: money.MustParse("6"), Status: "rewarded", Address: keyAddress(alice)})
+	repo.addWithdraw(&biz.Withdraw{ID: 2, UserId: 1, Amount: money.MustParse("8"), RelAmount: money.MustParse("8"), Status: "pass", Address: keyAddress(alice)})
+	repo.addWithdraw(&biz.Withdraw{ID: 3, UserId: 1, Amount: money.MustParse("2"), RelAmount: money.MustParse("2"), Status: "rewarded", Address: keyAddress(alice)})
+
+	// 转审核之后才创建的，发送前要再判断一次
+	repo.beforeList = func(status string) {
+		if "pass" == status {
+			repo.beforeList = nil
+			repo.addWithdraw(&biz.Withdraw{ID: 4, UserId: 1, Amount: money.MustParse("7"), RelAmount: money.MustParse("7"), Status: "rewarded", Address: keyAddress(alice)})
+		}
+	}
+	u := newTestService(t, chain, repo, nil)
+
+	if err := u.withdrawJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	for id, status := range map[uint64]string{1: "review", 2: "broadcast", 3: "broadcast", 4: "review"} {
+		if w := repo.withdraw(id); status != w.Status {
+			t.Fatalf("withdraw %d = %+v, want %s", id, w, status)
+		}
+	}
+}
+
+func TestWithdrawJobAbortsOnReviewError(t *testing.T) {
+	ctx := context.Background()
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+
+	repo := newTestStore(t)
+	repo.setConfig("withdraw_review_amount", "5")
+	repo.reviewErr = fmt.Errorf("db down")
+	repo.addUser(1, keyAddress(alice), "50")
+	repo.addWithdraw(&biz.Withdraw{ID: 1, UserId: 1, Amount: money.MustParse("6"), RelAmount: money.MustParse("6"), Status: "rewarded", Address: keyAddress(alice)})
+	repo.addWithdraw(&biz.Withdraw{ID: 2, UserId: 1, Amount: money.MustParse("2"), RelAmount: money.MustParse("2"), Status: "rewarded", Address: keyAddress(alice)})
+	u := newTestService(t, chain, repo, nil)
+
+	if err := u.withdrawJob(ctx); nil == err {
+		t.Fatal("withdrawJob() should fail when routing to review fails")
+	}
+
+	// 转审核失败时本次不发放
+	for _, id := range []uint64{1, 2} {
+		if w := repo.withdraw(id); "rewarded" != w.Status {
+			t.Fatalf("withdraw %d = %+v", id, w)
+		}
+	}
+}
+
+func TestWithdrawRPC(t *testing.T) {
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+
+	repo := newTestStore(t)
+	repo.setConfig("withdraw_fee_flat", "1")
+	repo.addUser(1, keyAddress(alice), "50")
+	u := newTestService(t, chain, repo, nil)
+
+	if _, err := u.Withdraw(context.Background(), &pb.WithdrawRequest{SendBody: &pb.WithdrawRequest_SendBody{Amount: "10"}}); nil == err {
+		t.Fatal("Withdraw() without login should fail")
+	}
+
+	ctx := jwt.NewContext(context.Background(), jwt2.MapClaims{"UserType": "user", "UserId": float64(1)})
+	if _, err := u.Withdraw(ctx, &pb.WithdrawRequest{}); nil == err {
+		t.Fatal("Withdraw() without body should fail")
+	}
+
+	res, err := u.Withdraw(ctx, &pb.WithdrawRequest{SendBody: &pb.WithdrawRequest_SendBody{Amount: "10"}})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if "10" != res.Amount || "1" != res.Fee || "9" != res.RelAmount || "rewarded" != res.Status {
+		t.Fatalf("Withdraw() = %+v", res)
+	}
+
+	// 到账地址是绑定的钱包地址
+	if w := repo.withdraw(res.Id); keyAddress(alice) != w.Address {
+		t.Fatalf("withdraw = %+v", w)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "40")
+	assertMoney(t, "withdraw", repo.balance(biz.AccountWithdraw), "9")
+	assertMoney(t, "fee", repo.balance(biz.AccountFee), "1")
+
+	// 平台账户不缓存余额，记账不改平台账户行
+	var cached int64
+	if err = repo.db.Table("ledger_account").Where("user_id=? and balance<>?", 0, 0).Count(&cached).Error; nil != err || 0 != cached {
+		t.Fatalf("platform balance rows = %d, %v", cached, err)
+	}
+
+	if _, err = u.Withdraw(ctx, &pb.WithdrawRequest{SendBody: &pb.WithdrawRequest_SendBody{Amount: "0"}}); nil == err {
+		t.Fatal("Withdraw() with zero amount should fail")
+	}
+
+	// 驳回时手续费一起冲回
+	reply, err := u.AdminWithdrawReview(ctx, &pb.AdminWithdrawReviewRequest{SendBody: &pb.AdminWithdrawReviewRequest_SendBody{Id: res.Id, Action: "reject"}})
+	if nil != err || "ok" != reply.Status {
+		t.Fatalf("AdminWithdrawReview() = %v, %v", reply, err)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "50")
+	assertMoney(t, "withdraw", repo.balance(biz.AccountWithdraw), "0")
+	assertMoney(t, "fee", repo.balance(biz.AccountFee), "0")
+}
+
+func TestWithdrawDustCarry(t *testing.T) {
+	alice := mustKey(t)
+	chain := newTestChain(t, alice)
+	ctx := jwt.NewContext(context.Background(), jwt2.MapClaims{"UserType": "user", "UserId": float64(1)})
+
+	repo := newTestStore(t)
+	repo.setConfig("withdraw_workers", "1")
+	repo.setConfig("withdraw_dust_policy", "carry")
+	repo.addUser(1, keyAddress(alice), "50")
+	repo.addWithdraw(&biz.Withdraw{ID: 1, UserId: 1, Amount: money.MustParse("0.0001"), RelAmount: money.MustParse("0.0001"), Status: "rewarded", Address: keyAddress(alice)})
+	// 留到下次但超过期限的
+	repo.addWithdraw(&biz.Withdraw{ID: 2, UserId: 1, Amount: money.MustParse("0.0002"), RelAmount: money.MustParse("0.0002"), Status: "rejected_dust", Dust: "carry", Address: keyAddress(alice), UpdatedAt: time.Now().Add(-8 * 24 * time.Hour)})
+	u := newTestService(t, chain, repo, nil)
+
+	if err := u.withdrawJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+
+	if w := repo.withdraw(1); "rejected_dust" != w.Status || "carry" != w.Dust {
+		t.Fatalf("withdraw 1 = %+v", w)
+	}
+	if w := repo.withdraw(2); "refunded" != w.Dust || 1 != repo.refunds(2) {
+		t.Fatalf("withdraw 2 = %+v", w)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "49.9999")
+
+	// 下次提现并入
+	res, err := u.Withdraw(ctx, &pb.WithdrawRequest{SendBody: &pb.WithdrawRequest_SendBody{Amount: "10"}})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if "10.0001" != res.RelAmount {
+		t.Fatalf("Withdraw() = %+v", res)
+	}
+	if w := repo.withdraw(1); "carried" != w.Dust || res.Id != w.CarryId {
+		t.Fatalf("withdraw 1 = %+v", w)
+	}
+
+	// 并入的那笔未发放时一起退回
+	reply, err := u.AdminWithdrawReview(ctx, &pb.AdminWithdrawReviewRequest{SendBody: &pb.AdminWithdrawReviewRequest_SendBody{Id: res.Id, Action: "reject"}})
+	if nil != err || "ok" != reply.Status {
+		t.Fatalf("AdminWithdrawReview() = %v, %v", reply, err)
+	}
+	if w := repo.withdraw(1); "refunded" != w.Dust || 1 != repo.refunds(1) {
+		t.Fatalf("withdraw 1 = %+v", w)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "50")
+	assertMoney(t, "withdraw", repo.balance(biz.AccountWithdraw), "0")
+
+	// 改为 refund 后剩下的 carry 直接退回
+	repo.addWithdraw(&biz.Withdraw{ID: 10, UserId: 1, Amount: money.MustParse("0.0003"), RelAmount: money.MustParse("0.0003"), Status: "rejected_dust", Dust: "carry", Address: keyAddress(alice), UpdatedAt: time.Now()})
+	repo.setConfig("withdraw_dust_policy", "refund")
+	if err = u.withdrawJob(ctx); nil != err {
+		t.Fatal(err)
+	}
+	if w := repo.withdraw(10); "refunded" != w.Dust {
+		t.Fatalf("withdraw 10 = %+v", w)
+	}
+	assertMoney(t, "alice", repo.balance(biz.UserAccount(1)), "50")
+}
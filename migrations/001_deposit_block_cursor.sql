@@ -0,0 +1,14 @@
+-- 按区块扫描充值，记录充值所在区块和每个合约的扫块游标
+ALTER TABLE `eth_user_record`
+    ADD COLUMN `block_number` bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE `eth_block_cursor` (
+    `id` int NOT NULL AUTO_INCREMENT,
+    `contract` varchar(100) NOT NULL,
+    `block_number` bigint NOT NULL,
+    `block_hash` varchar(100) NOT NULL,
+    `created_at` datetime NOT NULL,
+    `updated_at` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_contract` (`contract`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
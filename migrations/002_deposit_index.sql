@@ -0,0 +1,6 @@
+-- 充值记录按链、合约、下标唯一，旧记录 deposit_index 为 NULL 不受约束
+ALTER TABLE `eth_user_record`
+    ADD COLUMN `chain_id` bigint NOT NULL DEFAULT 0,
+    ADD COLUMN `contract` varchar(100) NOT NULL DEFAULT '',
+    ADD COLUMN `deposit_index` bigint NULL DEFAULT NULL,
+    ADD UNIQUE KEY `uniq_chain_contract_index` (`chain_id`, `contract`, `deposit_index`);
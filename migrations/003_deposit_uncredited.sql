@@ -0,0 +1,3 @@
+-- 入账关闭时只记录充值，uncredited 为 1 的之后补入账，旧记录都已入账
+ALTER TABLE `eth_user_record`
+    ADD COLUMN `uncredited` int NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+-- 扫块游标按链和合约区分，旧游标 chain_id 为 0，执行后改成对应来源的 chain_id，否则按没有游标处理
+ALTER TABLE `eth_block_cursor`
+    ADD COLUMN `chain_id` bigint NOT NULL DEFAULT 0,
+    DROP KEY `uniq_contract`,
+    ADD UNIQUE KEY `uniq_chain_contract` (`chain_id`, `contract`);
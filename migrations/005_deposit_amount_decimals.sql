@@ -0,0 +1,4 @@
+-- 充值金额按代币最小单位存整数，decimals 为其小数位，旧记录为 0
+ALTER TABLE `eth_user_record`
+    MODIFY COLUMN `amount` varchar(100) NOT NULL,
+    ADD COLUMN `decimals` int NOT NULL DEFAULT 0;
@@ -0,0 +1,19 @@
+-- 低于最小充值金额的充值
+CREATE TABLE `eth_deposit_pending` (
+    `id` int NOT NULL AUTO_INCREMENT,
+    `user_id` int NOT NULL,
+    `address` varchar(100) NOT NULL,
+    `hash` varchar(100) NOT NULL,
+    `amount` varchar(100) NOT NULL,
+    `decimals` int NOT NULL,
+    `block_number` bigint NOT NULL,
+    `chain_id` bigint NOT NULL,
+    `contract` varchar(100) NOT NULL,
+    `deposit_index` bigint NOT NULL,
+    `status` varchar(45) NOT NULL,
+    `remark` varchar(200) NOT NULL DEFAULT '',
+    `created_at` datetime NOT NULL,
+    `updated_at` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uniq_chain_contract_index` (`chain_id`, `contract`, `deposit_index`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
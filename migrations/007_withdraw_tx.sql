@@ -0,0 +1,11 @@
+-- 提现交易的广播和确认记录
+ALTER TABLE `withdraw`
+    ADD COLUMN `tx_hash` varchar(100) NOT NULL DEFAULT '',
+    ADD COLUMN `tx_history` text NULL,
+    ADD COLUMN `nonce` bigint NOT NULL DEFAULT 0,
+    ADD COLUMN `gas_price` varchar(100) NOT NULL DEFAULT '',
+    ADD COLUMN `gas_limit` bigint NOT NULL DEFAULT 0,
+    ADD COLUMN `rpc` varchar(200) NOT NULL DEFAULT '',
+    ADD COLUMN `attempts` int NOT NULL DEFAULT 0,
+    ADD COLUMN `raw_tx` text NULL,
+    ADD COLUMN `broadcast_at` datetime NULL DEFAULT NULL;
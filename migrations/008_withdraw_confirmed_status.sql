@@ -0,0 +1,3 @@
+-- 提现到账状态由 success 改为 confirmed，后台和前端按 confirmed 筛选已到账，旧数据一起改掉
+-- 改之前标记为 failed 的提现没有自动退款，执行前先导出 status='failed' 的记录核对，需要退款的人工处理
+UPDATE `withdraw` SET `status` = 'confirmed' WHERE `status` = 'success';
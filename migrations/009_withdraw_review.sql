@@ -0,0 +1,3 @@
+-- 提现审核备注
+ALTER TABLE `withdraw`
+    ADD COLUMN `remark` varchar(200) NOT NULL DEFAULT '';
@@ -0,0 +1,15 @@
+-- 提现手续费，fee_record 为平台手续费，退款时记负数
+ALTER TABLE `withdraw`
+    ADD COLUMN `fee` decimal(65, 20) NOT NULL DEFAULT 0;
+
+CREATE TABLE `fee_record` (
+    `id` int NOT NULL AUTO_INCREMENT,
+    `account` varchar(45) NOT NULL,
+    `user_id` int NOT NULL,
+    `withdraw_id` int NOT NULL,
+    `amount` decimal(65, 20) NOT NULL,
+    `created_at` datetime NOT NULL,
+    `updated_at` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_fee_record_account` (`account`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,4 @@
+-- 金额过小的提现，dust 为处理方式，carry_id 为并入的提现
+ALTER TABLE `withdraw`
+    ADD COLUMN `dust` varchar(45) NOT NULL DEFAULT '',
+    ADD COLUMN `carry_id` int NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- 提现交易实际消耗的 gas
+ALTER TABLE `withdraw`
+    ADD COLUMN `gas_used` bigint NOT NULL DEFAULT 0,
+    ADD COLUMN `gas_cost` varchar(100) NOT NULL DEFAULT '';
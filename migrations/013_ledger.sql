@@ -0,0 +1,32 @@
+-- 复式记账
+CREATE TABLE `ledger_account` (
+    `id` int NOT NULL AUTO_INCREMENT,
+    `code` varchar(100) NOT NULL,
+    `user_id` int NOT NULL DEFAULT 0,
+    `balance` decimal(65, 20) NOT NULL DEFAULT 0, -- 只缓存用户账户，平台账户按 ledger_posting 合计
+    `created_at` datetime NOT NULL,
+    `updated_at` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_ledger_account_code` (`code`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `ledger_journal` (
+    `id` int NOT NULL AUTO_INCREMENT,
+    `reason` int NOT NULL,
+    `ref` varchar(200) NOT NULL DEFAULT '',
+    `remark` varchar(200) NOT NULL DEFAULT '',
+    `created_at` datetime NOT NULL,
+    `updated_at` datetime NOT NULL,
+    PRIMARY KEY (`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+CREATE TABLE `ledger_posting` (
+    `id` int NOT NULL AUTO_INCREMENT,
+    `journal_id` int NOT NULL,
+    `account_id` int NOT NULL,
+    `amount` decimal(65, 20) NOT NULL,
+    `created_at` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_ledger_posting_journal_id` (`journal_id`),
+    KEY `idx_ledger_posting_account_id` (`account_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
@@ -0,0 +1,17 @@
+-- 用户之间划转，同一用户请求编号唯一
+CREATE TABLE `transfer` (
+    `id` int NOT NULL AUTO_INCREMENT,
+    `user_id` int NOT NULL,
+    `to_user_id` int NOT NULL,
+    `amount` decimal(65, 20) NOT NULL,
+    `fee` decimal(65, 20) NOT NULL DEFAULT 0,
+    `idem_key` varchar(100) NOT NULL,
+    `created_at` datetime NOT NULL,
+    `updated_at` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_user_idem` (`user_id`, `idem_key`),
+    KEY `idx_transfer_to_user_id` (`to_user_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+-- 1 禁止划转，转出和收款都拒绝
+ALTER TABLE `user` ADD COLUMN `lock_transfer` int NOT NULL DEFAULT 0;
@@ -0,0 +1,19 @@
+-- 余额对账差异
+CREATE TABLE `balance_drift` (
+    `id` int NOT NULL AUTO_INCREMENT,
+    `run_id` varchar(20) NOT NULL,
+    `user_id` int NOT NULL,
+    `stored` decimal(65, 20) NOT NULL,
+    `expected` decimal(65, 20) NOT NULL,
+    `drift` decimal(65, 20) NOT NULL,
+    `detail` varchar(1000) NOT NULL DEFAULT '',
+    `created_at` datetime NOT NULL,
+    `updated_at` datetime NOT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_balance_drift_run_id` (`run_id`),
+    KEY `idx_balance_drift_user_id` (`user_id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4;
+
+-- 用户开户时 reward 表的最大 id，对账只算之后的 reward，已开户的按开户时间补一次
+ALTER TABLE `ledger_account` ADD COLUMN `reward_id` int NOT NULL DEFAULT 0;
+UPDATE `ledger_account` SET `reward_id` = (SELECT COALESCE(MAX(`id`), 0) FROM `reward` WHERE `reward`.`created_at` < `ledger_account`.`created_at`) WHERE `user_id` > 0;